@@ -2,41 +2,50 @@ package config
 
 import (
 	"fmt"
-	"os"
+	"io/fs"
+	"path/filepath"
+	"strings"
 
 	yaml "gopkg.in/yaml.v3"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
 )
 
+type FileSystem interface {
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+}
+
 type Config struct {
 	store    store
 	location string
+	fs       FileSystem
 }
 
-func New(location string) (*Config, error) {
+func New(location string, fsys FileSystem) (*Config, error) {
 	return &Config{
 		location: location,
+		fs:       fsys,
 	}, nil
 }
 
 func (c *Config) Load() error {
-	cfgFile, err := os.Open(c.location)
+	content, err := c.fs.ReadFile(c.location)
 	if err != nil {
 		return fmt.Errorf("config file not found: %s", c.location)
 	}
-	defer func() {
-		err = cfgFile.Close()
-		if err != nil {
-			fmt.Println(err)
-		}
-	}()
 
-	err = yaml.NewDecoder(cfgFile).Decode(&c.store)
+	// the store is replaced, so that the entries removed from the file are
+	// not kept when the config is loaded again
+	var s store
+
+	err = yaml.Unmarshal(content, &s)
 	if err != nil {
-		return fmt.Errorf("yaml: decode: %w", err)
+		return fmt.Errorf("yaml: unmarshal: %w", err)
 	}
 
+	c.store = s
+
 	return nil
 }
 
@@ -68,6 +77,90 @@ func (c *Config) GetPackageTemplate() string {
 	return c.store.Templates.Package
 }
 
+func (c *Config) GetEntryPointTemplate() string {
+	if c.store.Templates.Cmd == "" {
+		return "std"
+	}
+	return c.store.Templates.Cmd
+}
+
+// GetLayout returns the directory layout of the project. Paths which are not
+// declared in the config have their default values.
+func (c *Config) GetLayout() Layout {
+	l := c.store.Layout
+
+	for _, field := range []struct {
+		value        *string
+		defaultValue string
+	}{
+		{&l.EntryPoint, "cmd"},
+		{&l.Domain, "internal/domain"},
+		{&l.Service, "service"},
+		{&l.Application, "application"},
+		{&l.DomainPort, "port"},
+		{&l.Infrastructure, "internal/infrastructure"},
+		{&l.Package, "internal/pkg"},
+		{&l.GlobalPackage, "pkg"},
+		{&l.Port, "internal/port"},
+	} {
+		if *field.value == "" {
+			*field.value = field.defaultValue
+		}
+		*field.value = filepath.Clean(filepath.FromSlash(*field.value))
+	}
+
+	return l
+}
+
+// GetKinds returns the component kinds declared in the config by their names.
+// Missing values have their defaults: the directory is the kind name in the
+// domain, or in "internal" for the kinds out of a domain, and the template is
+// std.
+func (c *Config) GetKinds() map[string]Kind {
+	kinds := make(map[string]Kind, len(c.store.Kinds))
+
+	for name, k := range c.store.Kinds {
+		if k.Dir == "" {
+			k.Dir = name
+			if !k.PerDomain {
+				k.Dir = filepath.Join("internal", name)
+			}
+		}
+		k.Dir = filepath.Clean(filepath.FromSlash(k.Dir))
+
+		if k.Template == "" {
+			k.Template = "std"
+		}
+
+		kinds[name] = k
+	}
+
+	return kinds
+}
+
+// GetLintRules returns the import rules declared in the config. If there is
+// no rule declared, the default hexagonal rules are returned.
+func (c *Config) GetLintRules() []LintRule {
+	if len(c.store.Lint.Rules) == 0 {
+		return defaultLintRules(c.GetLayout())
+	}
+	return c.store.Lint.Rules
+}
+
+func (c *Config) GetMocksDir() string {
+	if c.store.Mocks.Dir == "" {
+		return filepath.Join(c.GetLayout().Port, "mocks")
+	}
+	return c.store.Mocks.Dir
+}
+
+func (c *Config) GetMockStyle() string {
+	if c.store.Mocks.Style == "" {
+		return "testify"
+	}
+	return c.store.Mocks.Style
+}
+
 func (c *Config) GetRunner(runner string) (*Runner, error) {
 	if c.store.Runners == nil {
 		return nil, customerrors.ErrRunnerNotImplemented
@@ -78,3 +171,154 @@ func (c *Config) GetRunner(runner string) (*Runner, error) {
 	}
 	return v, nil
 }
+
+// RemoveRunner removes the runner from the config file. Only the lines of the
+// runner entry are deleted, so comments and the rest of the file are kept as
+// they are. It reports whether the runner existed.
+func (c *Config) RemoveRunner(runner string) (bool, error) {
+	content, err := c.fs.ReadFile(c.location)
+	if err != nil {
+		return false, fmt.Errorf("config file not found: %s", c.location)
+	}
+
+	runners, err := runnersNode(content)
+	if err != nil {
+		return false, fmt.Errorf("runners node: %w", err)
+	}
+
+	if runners == nil {
+		return false, nil
+	}
+
+	if runners.Style&yaml.FlowStyle != 0 {
+		return false, fmt.Errorf("runners of %s must be in block style to be removed", c.location)
+	}
+
+	for i := 0; i+1 < len(runners.Content); i += 2 {
+		key, value := runners.Content[i], runners.Content[i+1]
+		if key.Value != runner {
+			continue
+		}
+
+		lines := strings.SplitAfter(string(content), "\n")
+		lines = append(lines[:key.Line-1], lines[lastLine(value):]...)
+
+		err = c.fs.WriteFile(c.location, []byte(strings.Join(lines, "")), 0o600)
+		if err != nil {
+			return false, fmt.Errorf("fs: write file: %w", err)
+		}
+
+		delete(c.store.Runners, runner)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// RenamedRunner returns the content of the config file with the key of the
+// runner renamed. The file is not written, so that the rename can be applied
+// together with other changes. The content is nil if the config file has no
+// such runner.
+func (c *Config) RenamedRunner(runner, newName string) ([]byte, error) {
+	content, err := c.fs.ReadFile(c.location)
+	if err != nil {
+		return nil, fmt.Errorf("config file not found: %s", c.location)
+	}
+
+	runners, err := runnersNode(content)
+	if err != nil {
+		return nil, fmt.Errorf("runners node: %w", err)
+	}
+
+	if runners == nil {
+		return nil, nil
+	}
+
+	if runners.Style&yaml.FlowStyle != 0 {
+		return nil, fmt.Errorf("runners of %s must be in block style to be renamed", c.location)
+	}
+
+	if mappingValue(runners, newName) != nil {
+		return nil, fmt.Errorf("runner %s: %w", newName, customerrors.ErrAlreadyExist)
+	}
+
+	for i := 0; i+1 < len(runners.Content); i += 2 {
+		key := runners.Content[i]
+		if key.Value != runner {
+			continue
+		}
+
+		start := key.Column - 1
+		end := start + len(key.Value)
+		if key.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+			end += 2
+		}
+
+		lines := strings.SplitAfter(string(content), "\n")
+		line := lines[key.Line-1]
+		if end > len(line) || !strings.Contains(line[start:end], key.Value) {
+			return nil, fmt.Errorf("runner %s of %s can not be renamed", runner, c.location)
+		}
+
+		lines[key.Line-1] = line[:start] + newName + line[end:]
+
+		return []byte(strings.Join(lines, "")), nil
+	}
+
+	return nil, nil
+}
+
+// Location returns the path of the config file.
+func (c *Config) Location() string {
+	return c.location
+}
+
+// runnersNode returns the runners mapping of the config file, or nil if the
+// file has none.
+func runnersNode(content []byte) (*yaml.Node, error) {
+	var root yaml.Node
+
+	err := yaml.Unmarshal(content, &root)
+	if err != nil {
+		return nil, fmt.Errorf("yaml: unmarshal: %w", err)
+	}
+
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	runners := mappingValue(root.Content[0], "runners")
+	if runners == nil || runners.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	return runners, nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// lastLine returns the last line of the node in the file.
+func lastLine(node *yaml.Node) int {
+	if len(node.Content) > 0 {
+		return lastLine(node.Content[len(node.Content)-1])
+	}
+
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return node.Line + strings.Count(strings.TrimSuffix(node.Value, "\n"), "\n") + 1
+	}
+
+	return node.Line
+}
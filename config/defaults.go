@@ -0,0 +1,62 @@
+package config
+
+import (
+	"path"
+	"path/filepath"
+)
+
+// defaultLintRules returns the default hexagonal rules for the layout.
+func defaultLintRules(l Layout) []LintRule {
+	var (
+		domains         = filepath.ToSlash(l.Domain)
+		services        = path.Join(domains, "*", filepath.ToSlash(l.Service), "**")
+		applications    = path.Join(domains, "*", filepath.ToSlash(l.Application), "**")
+		infrastructures = path.Join(filepath.ToSlash(l.Infrastructure), "**")
+	)
+
+	return []LintRule{
+		{
+			Name: "service-dependencies",
+			From: services,
+			Deny: []string{
+				infrastructures,
+				applications,
+			},
+		},
+		{
+			Name: "application-dependencies",
+			From: applications,
+			Deny: []string{
+				infrastructures,
+			},
+		},
+		{
+			Name:  "domain-isolation",
+			From:  path.Join(domains, "{domain}", "**"),
+			Deny:  []string{path.Join(domains, "**")},
+			Allow: []string{path.Join(domains, "{domain}", "**")},
+		},
+		{
+			Name: "port-dependencies",
+			From: path.Join(filepath.ToSlash(l.Port), "**"),
+			Deny: []string{
+				infrastructures,
+				services,
+				applications,
+			},
+		},
+		{
+			Name: "infrastructure-dependencies",
+			From: infrastructures,
+			Deny: []string{
+				services,
+				applications,
+			},
+		},
+		{
+			Name: "global-package-dependencies",
+			From: path.Join(filepath.ToSlash(l.GlobalPackage), "**"),
+			Deny: []string{"internal/**"},
+		},
+	}
+}
@@ -3,6 +3,10 @@ package config
 type store struct {
 	Runners   map[string]*Runner `yaml:"runners"`
 	Templates templates          `yaml:"templates"`
+	Lint      lint               `yaml:"lint"`
+	Mocks     mocks              `yaml:"mocks"`
+	Layout    Layout             `yaml:"layout"`
+	Kinds     map[string]Kind    `yaml:"kinds"`
 }
 
 type templates struct {
@@ -10,6 +14,7 @@ type templates struct {
 	Application    string `yaml:"application"`
 	Infrastructure string `yaml:"infrastructure"`
 	Package        string `yaml:"package"`
+	Cmd            string `yaml:"cmd"`
 }
 
 type Runner struct {
@@ -23,3 +28,42 @@ type log struct {
 	SeperateFiles bool `yaml:"seperate_files"`
 	Overwrite     bool `yaml:"overwrite"`
 }
+
+type lint struct {
+	Rules []LintRule `yaml:"rules"`
+}
+
+type LintRule struct {
+	Name  string   `yaml:"name"`
+	From  string   `yaml:"from"`
+	Deny  []string `yaml:"deny"`
+	Allow []string `yaml:"allow"`
+}
+
+type mocks struct {
+	Dir   string `yaml:"dir"`
+	Style string `yaml:"style"`
+}
+
+// Layout is the directory layout of the project. Paths are relative to the
+// project root. Service, Application and DomainPort are relative to the
+// directory of a domain.
+type Layout struct {
+	EntryPoint     string `yaml:"cmd"`
+	Domain         string `yaml:"domain"`
+	Service        string `yaml:"service"`
+	Application    string `yaml:"application"`
+	DomainPort     string `yaml:"domain_port"`
+	Infrastructure string `yaml:"infrastructure"`
+	Package        string `yaml:"package"`
+	GlobalPackage  string `yaml:"global_package"`
+	Port           string `yaml:"port"`
+}
+
+// Kind is a component kind declared by the user, like repository or handler.
+type Kind struct {
+	Dir       string `yaml:"dir"`        // base directory, relative to the domain directory if PerDomain is set
+	PerDomain bool   `yaml:"per_domain"` // whether the components are created in a domain
+	Suffix    string `yaml:"suffix"`     // suffix which the struct names must end with, e.g. Repository
+	Template  string `yaml:"template"`   // template mode, std by default
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ksckaan1/hexago/config"
@@ -11,19 +12,24 @@ import (
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/entrypointcmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/infracmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/initcmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/kindcmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/lintcmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/packagecmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/portcmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/rootcmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/runnercmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/servicecmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/templatecmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/treecmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/wirecmd"
 	"github.com/ksckaan1/hexago/internal/domain/core/service/project"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 )
 
-func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *config.Config) (*cli.CLI, error) {
+func initCommands(ctx context.Context, projectService *project.Project, tl *tuilog.TUILog, cfg *config.Config, fsys *filesystem.DryRun) (*cli.CLI, error) {
 	// root
-	rootCmd, err := rootcmd.NewRootCommand()
+	rootCmd, err := rootcmd.NewRootCommand(fsys, tl)
 	if err != nil {
 		return nil, fmt.Errorf("rootcmd.NewRootCommand: %w", err)
 	}
@@ -50,6 +56,16 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("domaincmd.NewDomainCreateCommand: %w", err)
 	}
 
+	domainRemoveCmd, err := domaincmd.NewDomainRemoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("domaincmd.NewDomainRemoveCommand: %w", err)
+	}
+
+	domainMoveCmd, err := domaincmd.NewDomainMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("domaincmd.NewDomainMoveCommand: %w", err)
+	}
+
 	// service
 	serviceCmd, err := servicecmd.NewServiceCommand()
 	if err != nil {
@@ -66,6 +82,16 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("servicecmd.NewServiceCreateCommand: %w", err)
 	}
 
+	serviceRemoveCmd, err := servicecmd.NewServiceRemoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("servicecmd.NewServiceRemoveCommand: %w", err)
+	}
+
+	serviceMoveCmd, err := servicecmd.NewServiceMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("servicecmd.NewServiceMoveCommand: %w", err)
+	}
+
 	// port
 	portCmd, err := portcmd.NewPortCommand()
 	if err != nil {
@@ -77,6 +103,21 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("portcmd.NewPortLSCommand: %w", err)
 	}
 
+	portCreateCmd, err := portcmd.NewPortCreateCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("portcmd.NewPortCreateCommand: %w", err)
+	}
+
+	portSyncCmd, err := portcmd.NewPortSyncCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("portcmd.NewPortSyncCommand: %w", err)
+	}
+
+	portMockCmd, err := portcmd.NewPortMockCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("portcmd.NewPortMockCommand: %w", err)
+	}
+
 	// app
 	appCmd, err := appcmd.NewAppCommand()
 	if err != nil {
@@ -93,6 +134,16 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("appcmd.NewAppCreateCommand: %w", err)
 	}
 
+	appRemoveCmd, err := appcmd.NewAppRemoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("appcmd.NewAppRemoveCommand: %w", err)
+	}
+
+	appMoveCmd, err := appcmd.NewAppMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("appcmd.NewAppMoveCommand: %w", err)
+	}
+
 	// cmd
 	entryPointCmd, err := entrypointcmd.NewEntryPointCommand()
 	if err != nil {
@@ -104,11 +155,21 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("entrypointcmd.NewEntryPointLSCommand: %w", err)
 	}
 
-	entryPointCreateCmd, err := entrypointcmd.NewEntryPointCreateCommand(projectService, tl)
+	entryPointCreateCmd, err := entrypointcmd.NewEntryPointCreateCommand(projectService, cfg, tl)
 	if err != nil {
 		return nil, fmt.Errorf("entrypointcmd.NewEntryPointCreateCommand: %w", err)
 	}
 
+	entryPointRemoveCmd, err := entrypointcmd.NewEntryPointRemoveCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("entrypointcmd.NewEntryPointRemoveCommand: %w", err)
+	}
+
+	entryPointMoveCmd, err := entrypointcmd.NewEntryPointMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("entrypointcmd.NewEntryPointMoveCommand: %w", err)
+	}
+
 	// infra
 	infraCmd, err := infracmd.NewInfraCommand()
 	if err != nil {
@@ -125,6 +186,16 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("infracmd.NewInfraCreateCommand: %w", err)
 	}
 
+	infraRemoveCmd, err := infracmd.NewInfraRemoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("infracmd.NewInfraRemoveCommand: %w", err)
+	}
+
+	infraMoveCmd, err := infracmd.NewInfraMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("infracmd.NewInfraMoveCommand: %w", err)
+	}
+
 	// pkg
 	packageCmd, err := packagecmd.NewPackageCommand()
 	if err != nil {
@@ -141,6 +212,16 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("packagecmd.NewPackageCreateCommand: %w", err)
 	}
 
+	packageRemoveCmd, err := packagecmd.NewPackageRemoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("packagecmd.NewPackageRemoveCommand: %w", err)
+	}
+
+	packageMoveCmd, err := packagecmd.NewPackageMoveCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("packagecmd.NewPackageMoveCommand: %w", err)
+	}
+
 	// run
 	runnerCmd, err := runnercmd.NewRunnerCommand(projectService, cfg, tl)
 	if err != nil {
@@ -159,32 +240,124 @@ func initCommands(projectService *project.Project, tl *tuilog.TUILog, cfg *confi
 		return nil, fmt.Errorf("treecmd.NewTreeCommand: %w", err)
 	}
 
+	// wire
+	wireCmd, err := wirecmd.NewWireCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("wirecmd.NewWireCommand: %w", err)
+	}
+
+	// lint
+	lintCmd, err := lintcmd.NewLintCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("lintcmd.NewLintCommand: %w", err)
+	}
+
+	// template
+	templateCmd, err := templatecmd.NewTemplateCommand()
+	if err != nil {
+		return nil, fmt.Errorf("templatecmd.NewTemplateCommand: %w", err)
+	}
+
+	templateLSCmd, err := templatecmd.NewTemplateLSCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("templatecmd.NewTemplateLSCommand: %w", err)
+	}
+
+	templateEjectCmd, err := templatecmd.NewTemplateEjectCommand(projectService, tl)
+	if err != nil {
+		return nil, fmt.Errorf("templatecmd.NewTemplateEjectCommand: %w", err)
+	}
+
+	templateRenderCmd, err := templatecmd.NewTemplateRenderCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("templatecmd.NewTemplateRenderCommand: %w", err)
+	}
+
+	templateCheckCmd, err := templatecmd.NewTemplateCheckCommand(projectService, cfg, tl)
+	if err != nil {
+		return nil, fmt.Errorf("templatecmd.NewTemplateCheckCommand: %w", err)
+	}
+
+	// kinds in the config
+	kinds, err := projectService.GetCustomKinds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("projectService.GetCustomKinds: %w", err)
+	}
+
+	kindCmds := make([]cli.KindCommands, 0, len(kinds))
+
+	for _, kind := range kinds {
+		kindCmd, err2 := kindcmd.NewKindCommand(kind)
+		if err2 != nil {
+			return nil, fmt.Errorf("kindcmd.NewKindCommand: %w", err2)
+		}
+
+		kindLSCmd, err2 := kindcmd.NewKindLSCommand(projectService, kind, tl)
+		if err2 != nil {
+			return nil, fmt.Errorf("kindcmd.NewKindLSCommand: %w", err2)
+		}
+
+		kindCreateCmd, err2 := kindcmd.NewKindCreateCommand(projectService, cfg, kind, tl)
+		if err2 != nil {
+			return nil, fmt.Errorf("kindcmd.NewKindCreateCommand: %w", err2)
+		}
+
+		kindCmds = append(kindCmds, cli.KindCommands{
+			Cmd:       kindCmd,
+			LSCmd:     kindLSCmd,
+			CreateCmd: kindCreateCmd,
+		})
+	}
+
 	app, err := cli.New(
 		rootCmd,
 		initCmd,
 		domainCmd,
 		domainLSCmd,
 		domainCreateCmd,
+		domainRemoveCmd,
+		domainMoveCmd,
 		serviceCmd,
 		serviceLSCmd,
 		serviceCreateCmd,
+		serviceRemoveCmd,
+		serviceMoveCmd,
 		portCmd,
 		portLSCmd,
+		portCreateCmd,
+		portSyncCmd,
+		portMockCmd,
 		appCmd,
 		appLSCmd,
 		appCreateCmd,
+		appRemoveCmd,
+		appMoveCmd,
 		entryPointCmd,
 		entryPointLSCmd,
 		entryPointCreateCmd,
+		entryPointRemoveCmd,
+		entryPointMoveCmd,
 		infraCmd,
 		infraLSCmd,
 		infraCreateCmd,
+		infraRemoveCmd,
+		infraMoveCmd,
 		packageCmd,
 		packageLSCmd,
 		packageCreateCmd,
+		packageRemoveCmd,
+		packageMoveCmd,
 		runnerCmd,
 		doctorCmd,
 		treeCmd,
+		wireCmd,
+		lintCmd,
+		templateCmd,
+		templateLSCmd,
+		templateEjectCmd,
+		templateRenderCmd,
+		templateCheckCmd,
+		kindCmds,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("cli.New: %w", err)
@@ -3,6 +3,7 @@ package customerrors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -12,7 +13,9 @@ var (
 	ErrInvalidInstanceName  = errors.New("invalid instance name")
 	ErrInvalidPkgName       = errors.New("invalid pkg name")
 	ErrInvalidCmdName       = errors.New("invalid cmd name")
+	ErrInvalidFileName      = errors.New("invalid file name")
 	ErrTemplateCanNotParsed = errors.New("template can not parsed")
+	ErrInvalidTemplateName  = errors.New("invalid template name")
 	ErrAlreadyExist         = errors.New("already exist")
 	ErrSuppressed           = errors.New("")
 )
@@ -50,3 +53,138 @@ type ErrInvalidPortName struct {
 func (e ErrInvalidPortName) Error() string {
 	return fmt.Sprintf("invalid port name: %s", e.PortName)
 }
+
+type ErrMissingInput struct {
+	Flag string
+}
+
+func (e ErrMissingInput) Error() string {
+	return fmt.Sprintf("missing required value: --%s (prompts are disabled)", e.Flag)
+}
+
+type ErrInvalidOutputFormat struct {
+	Format string
+}
+
+func (e ErrInvalidOutputFormat) Error() string {
+	return fmt.Sprintf("invalid output format: %s (must be text, json or yaml)", e.Format)
+}
+
+type ErrInterfaceNotFound struct {
+	Name string
+}
+
+func (e ErrInterfaceNotFound) Error() string {
+	return fmt.Sprintf("interface not found: %s", e.Name)
+}
+
+type ErrCanNotImplement struct {
+	Interface string
+	Reason    string
+}
+
+func (e ErrCanNotImplement) Error() string {
+	return fmt.Sprintf("can not implement %s: %s", e.Interface, e.Reason)
+}
+
+type ErrAmbiguousPort struct {
+	PortName string
+	Domains  []string
+}
+
+func (e ErrAmbiguousPort) Error() string {
+	return fmt.Sprintf("ambiguous port: %s is declared in domains %s", e.PortName, strings.Join(e.Domains, ", "))
+}
+
+type ErrInvalidMethodSignature struct {
+	Signature string
+	Message   string
+}
+
+func (e ErrInvalidMethodSignature) Error() string {
+	return fmt.Sprintf("invalid method signature: %s (%s)", e.Signature, e.Message)
+}
+
+type ErrInvalidMockStyle struct {
+	Style string
+}
+
+func (e ErrInvalidMockStyle) Error() string {
+	return fmt.Sprintf("invalid mock style: %s (must be testify or func)", e.Style)
+}
+
+type ErrPackagesNotLoadable struct {
+	Reason string
+}
+
+func (e ErrPackagesNotLoadable) Error() string {
+	return fmt.Sprintf("packages can not be loaded from the file system: %s", e.Reason)
+}
+
+type ErrNoProviders struct {
+	EntryPoint string
+}
+
+func (e ErrNoProviders) Error() string {
+	return fmt.Sprintf("no component uses the do, fx or wire templates: %s", e.EntryPoint)
+}
+
+type ErrMissingRequirement struct {
+	Module string
+}
+
+func (e ErrMissingRequirement) Error() string {
+	return fmt.Sprintf("module is not required by go.mod: %s (run go get %s)", e.Module, e.Module)
+}
+
+type ErrComponentNotFound struct {
+	Kind string
+	Name string
+}
+
+func (e ErrComponentNotFound) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.Kind, e.Name)
+}
+
+type ErrInvalidTemplateKind struct {
+	Kind string
+}
+
+func (e ErrInvalidTemplateKind) Error() string {
+	return fmt.Sprintf("invalid template kind: %s (must be service, application, infra, package, cmd or a kind in the config)", e.Kind)
+}
+
+type ErrTemplateNotFound struct {
+	Kind string
+	Mode string
+}
+
+func (e ErrTemplateNotFound) Error() string {
+	return fmt.Sprintf("%s template not found: %s", e.Kind, e.Mode)
+}
+
+type ErrInvalidKind struct {
+	Kind   string
+	Reason string
+}
+
+func (e ErrInvalidKind) Error() string {
+	return fmt.Sprintf("invalid kind: %s (%s)", e.Kind, e.Reason)
+}
+
+type ErrDuplicateProvider struct {
+	Type       string
+	Components []string
+}
+
+func (e ErrDuplicateProvider) Error() string {
+	return fmt.Sprintf("duplicate provider: %s is provided by %s", e.Type, strings.Join(e.Components, ", "))
+}
+
+type ErrNotGenerated struct {
+	File string
+}
+
+func (e ErrNotGenerated) Error() string {
+	return fmt.Sprintf("file is not generated by hexago: %s", e.File)
+}
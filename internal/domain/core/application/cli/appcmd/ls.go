@@ -2,6 +2,7 @@ package appcmd
 
 import (
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -23,6 +27,7 @@ type AppLSCommand struct {
 
 	// flags
 	flagLine   *bool
+	flagOutput *string
 	flagDomain *string
 }
 
@@ -57,10 +62,17 @@ func (c *AppLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago app ls -l")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago app ls -o json|yaml")
 	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago app ls -d <domainname>")
 }
 
 func (c *AppLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
 	domains, err := c.projectService.GetAllDomains(cmd.Context())
 	if err != nil {
 
@@ -79,6 +91,8 @@ func (c *AppLSCommand) runner(cmd *cobra.Command, _ []string) error {
 	if *c.flagDomain == "" {
 		if len(domains) == 1 {
 			*c.flagDomain = domains[0]
+		} else if format != output.FormatText || !terminal.IsInteractive() {
+			*c.flagDomain = "*"
 		} else {
 
 			selectList := []huh.Option[string]{
@@ -106,13 +120,23 @@ func (c *AppLSCommand) runner(cmd *cobra.Command, _ []string) error {
 				return fmt.Errorf("select a domain: %w", err2)
 			}
 		}
-	} else if !slices.Contains(domains, *c.flagDomain) {
+	} else if *c.flagDomain != "*" && !slices.Contains(domains, *c.flagDomain) {
 
 		c.tuilog.Error("Domain not found: " + *c.flagDomain)
 
 		return fmt.Errorf("domain not found: %s", *c.flagDomain)
 	}
 
+	if format != output.FormatText {
+		components, err2 := c.projectService.GetAllComponents(cmd.Context(), model.KindApplication, *c.flagDomain)
+		if err2 != nil {
+			c.tuilog.Error(err2.Error())
+			return fmt.Errorf("projectService.GetAllComponents: %w", err2)
+		}
+
+		return output.Print(os.Stdout, format, components)
+	}
+
 	allApps := make([]string, 0)
 
 	for i := range domains {
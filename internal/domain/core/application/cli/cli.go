@@ -4,35 +4,75 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/spf13/cobra"
+
 	"github.com/ksckaan1/hexago/internal/port"
 )
 
+// KindCommander is the command of a component kind. It is registered to the
+// root command by itself, since its name must not collide with the commands
+// of the root command.
+type KindCommander interface {
+	port.Commander
+	Register(rootCmd *cobra.Command) error
+}
+
+// KindCommands are the commands of a component kind declared in the config.
+type KindCommands struct {
+	Cmd       KindCommander
+	LSCmd     port.Commander
+	CreateCmd port.Commander
+}
+
 type CLI struct {
 	rootCmd             port.Commander
 	initCmd             port.Commander
 	domainCmd           port.Commander
 	domainLSCmd         port.Commander
 	domainCreateCmd     port.Commander
+	domainRemoveCmd     port.Commander
+	domainMoveCmd       port.Commander
 	serviceCmd          port.Commander
 	serviceLSCmd        port.Commander
 	serviceCreateCmd    port.Commander
+	serviceRemoveCmd    port.Commander
+	serviceMoveCmd      port.Commander
 	portCmd             port.Commander
 	portLSCmd           port.Commander
+	portCreateCmd       port.Commander
+	portSyncCmd         port.Commander
+	portMockCmd         port.Commander
 	appCmd              port.Commander
 	appLSCmd            port.Commander
 	appCreateCmd        port.Commander
+	appRemoveCmd        port.Commander
+	appMoveCmd          port.Commander
 	entryPointCmd       port.Commander
 	entryPointLSCmd     port.Commander
 	entryPointCreateCmd port.Commander
+	entryPointRemoveCmd port.Commander
+	entryPointMoveCmd   port.Commander
 	infraCmd            port.Commander
 	infraLSCmd          port.Commander
 	infraCreateCmd      port.Commander
+	infraRemoveCmd      port.Commander
+	infraMoveCmd        port.Commander
 	packageCmd          port.Commander
 	packageLSCmd        port.Commander
 	packageCreateCmd    port.Commander
+	packageRemoveCmd    port.Commander
+	packageMoveCmd      port.Commander
 	runnerCmd           port.Commander
 	doctorCmd           port.Commander
 	treeCmd             port.Commander
+	wireCmd             port.Commander
+	lintCmd             port.Commander
+	templateCmd         port.Commander
+	templateLSCmd       port.Commander
+	templateEjectCmd    port.Commander
+	templateRenderCmd   port.Commander
+	templateCheckCmd    port.Commander
+	kindCmds            []KindCommands
 }
 
 func New(
@@ -41,26 +81,49 @@ func New(
 	domainCmd port.Commander,
 	domainLSCmd port.Commander,
 	domainCreateCmd port.Commander,
+	domainRemoveCmd port.Commander,
+	domainMoveCmd port.Commander,
 	serviceCmd port.Commander,
 	serviceLSCmd port.Commander,
 	serviceCreateCmd port.Commander,
+	serviceRemoveCmd port.Commander,
+	serviceMoveCmd port.Commander,
 	portCmd port.Commander,
 	portLSCmd port.Commander,
+	portCreateCmd port.Commander,
+	portSyncCmd port.Commander,
+	portMockCmd port.Commander,
 	appCmd port.Commander,
 	appLSCmd port.Commander,
 	appCreateCmd port.Commander,
+	appRemoveCmd port.Commander,
+	appMoveCmd port.Commander,
 	entryPointCmd port.Commander,
 	entryPointLSCmd port.Commander,
 	entryPointCreateCmd port.Commander,
+	entryPointRemoveCmd port.Commander,
+	entryPointMoveCmd port.Commander,
 	infraCmd port.Commander,
 	infraLSCmd port.Commander,
 	infraCreateCmd port.Commander,
+	infraRemoveCmd port.Commander,
+	infraMoveCmd port.Commander,
 	packageCmd port.Commander,
 	packageLSCmd port.Commander,
 	packageCreateCmd port.Commander,
+	packageRemoveCmd port.Commander,
+	packageMoveCmd port.Commander,
 	runnerCmd port.Commander,
 	doctorCmd port.Commander,
 	treeCmd port.Commander,
+	wireCmd port.Commander,
+	lintCmd port.Commander,
+	templateCmd port.Commander,
+	templateLSCmd port.Commander,
+	templateEjectCmd port.Commander,
+	templateRenderCmd port.Commander,
+	templateCheckCmd port.Commander,
+	kindCmds []KindCommands,
 ) (*CLI, error) {
 	return &CLI{
 		rootCmd:             rootCmd,
@@ -68,26 +131,49 @@ func New(
 		domainCmd:           domainCmd,
 		domainLSCmd:         domainLSCmd,
 		domainCreateCmd:     domainCreateCmd,
+		domainRemoveCmd:     domainRemoveCmd,
+		domainMoveCmd:       domainMoveCmd,
 		serviceCmd:          serviceCmd,
 		serviceLSCmd:        serviceLSCmd,
 		serviceCreateCmd:    serviceCreateCmd,
+		serviceRemoveCmd:    serviceRemoveCmd,
+		serviceMoveCmd:      serviceMoveCmd,
 		portCmd:             portCmd,
 		portLSCmd:           portLSCmd,
+		portCreateCmd:       portCreateCmd,
+		portSyncCmd:         portSyncCmd,
+		portMockCmd:         portMockCmd,
 		appCmd:              appCmd,
 		appLSCmd:            appLSCmd,
 		appCreateCmd:        appCreateCmd,
+		appRemoveCmd:        appRemoveCmd,
+		appMoveCmd:          appMoveCmd,
 		entryPointCmd:       entryPointCmd,
 		entryPointLSCmd:     entryPointLSCmd,
 		entryPointCreateCmd: entryPointCreateCmd,
+		entryPointRemoveCmd: entryPointRemoveCmd,
+		entryPointMoveCmd:   entryPointMoveCmd,
 		infraCmd:            infraCmd,
 		infraLSCmd:          infraLSCmd,
 		infraCreateCmd:      infraCreateCmd,
+		infraRemoveCmd:      infraRemoveCmd,
+		infraMoveCmd:        infraMoveCmd,
 		packageCmd:          packageCmd,
 		packageLSCmd:        packageLSCmd,
 		packageCreateCmd:    packageCreateCmd,
+		packageRemoveCmd:    packageRemoveCmd,
+		packageMoveCmd:      packageMoveCmd,
 		runnerCmd:           runnerCmd,
 		doctorCmd:           doctorCmd,
 		treeCmd:             treeCmd,
+		wireCmd:             wireCmd,
+		lintCmd:             lintCmd,
+		templateCmd:         templateCmd,
+		templateLSCmd:       templateLSCmd,
+		templateEjectCmd:    templateEjectCmd,
+		templateRenderCmd:   templateRenderCmd,
+		templateCheckCmd:    templateCheckCmd,
+		kindCmds:            kindCmds,
 	}, nil
 }
 
@@ -99,35 +185,50 @@ func (c *CLI) Run(ctx context.Context) error {
 	c.rootCmd.AddSubCommand(c.domainCmd)
 	c.domainCmd.AddSubCommand(c.domainLSCmd)
 	c.domainCmd.AddSubCommand(c.domainCreateCmd)
+	c.domainCmd.AddSubCommand(c.domainRemoveCmd)
+	c.domainCmd.AddSubCommand(c.domainMoveCmd)
 
 	// service
 	c.rootCmd.AddSubCommand(c.serviceCmd)
 	c.serviceCmd.AddSubCommand(c.serviceLSCmd)
 	c.serviceCmd.AddSubCommand(c.serviceCreateCmd)
+	c.serviceCmd.AddSubCommand(c.serviceRemoveCmd)
+	c.serviceCmd.AddSubCommand(c.serviceMoveCmd)
 
 	// port
 	c.rootCmd.AddSubCommand(c.portCmd)
 	c.portCmd.AddSubCommand(c.portLSCmd)
+	c.portCmd.AddSubCommand(c.portCreateCmd)
+	c.portCmd.AddSubCommand(c.portSyncCmd)
+	c.portCmd.AddSubCommand(c.portMockCmd)
 
 	// app
 	c.rootCmd.AddSubCommand(c.appCmd)
 	c.appCmd.AddSubCommand(c.appLSCmd)
 	c.appCmd.AddSubCommand(c.appCreateCmd)
+	c.appCmd.AddSubCommand(c.appRemoveCmd)
+	c.appCmd.AddSubCommand(c.appMoveCmd)
 
 	// cmd
 	c.rootCmd.AddSubCommand(c.entryPointCmd)
 	c.entryPointCmd.AddSubCommand(c.entryPointLSCmd)
 	c.entryPointCmd.AddSubCommand(c.entryPointCreateCmd)
+	c.entryPointCmd.AddSubCommand(c.entryPointRemoveCmd)
+	c.entryPointCmd.AddSubCommand(c.entryPointMoveCmd)
 
 	// infra
 	c.rootCmd.AddSubCommand(c.infraCmd)
 	c.infraCmd.AddSubCommand(c.infraLSCmd)
 	c.infraCmd.AddSubCommand(c.infraCreateCmd)
+	c.infraCmd.AddSubCommand(c.infraRemoveCmd)
+	c.infraCmd.AddSubCommand(c.infraMoveCmd)
 
 	// pkg
 	c.rootCmd.AddSubCommand(c.packageCmd)
 	c.packageCmd.AddSubCommand(c.packageLSCmd)
 	c.packageCmd.AddSubCommand(c.packageCreateCmd)
+	c.packageCmd.AddSubCommand(c.packageRemoveCmd)
+	c.packageCmd.AddSubCommand(c.packageMoveCmd)
 
 	// run
 	c.rootCmd.AddSubCommand(c.runnerCmd)
@@ -138,7 +239,32 @@ func (c *CLI) Run(ctx context.Context) error {
 	// tree
 	c.rootCmd.AddSubCommand(c.treeCmd)
 
-	err := c.rootCmd.Command().ExecuteContext(ctx)
+	// wire
+	c.rootCmd.AddSubCommand(c.wireCmd)
+
+	// lint
+	c.rootCmd.AddSubCommand(c.lintCmd)
+
+	// template
+	c.rootCmd.AddSubCommand(c.templateCmd)
+	c.templateCmd.AddSubCommand(c.templateLSCmd)
+	c.templateCmd.AddSubCommand(c.templateEjectCmd)
+	c.templateCmd.AddSubCommand(c.templateRenderCmd)
+	c.templateCmd.AddSubCommand(c.templateCheckCmd)
+
+	rootCmd := c.rootCmd.Command()
+
+	// kinds in the config
+	for _, kindCmd := range c.kindCmds {
+		err := kindCmd.Cmd.Register(rootCmd)
+		if err != nil {
+			return fmt.Errorf("kindCmd.Cmd.Register: %w", err)
+		}
+		kindCmd.Cmd.AddSubCommand(kindCmd.LSCmd)
+		kindCmd.Cmd.AddSubCommand(kindCmd.CreateCmd)
+	}
+
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		return fmt.Errorf("rootCmd.Command().ExecuteContext: %w", err)
 	}
@@ -69,11 +69,5 @@ func (c *DoctorCommand) runner(cmd *cobra.Command, _ []string) error {
 		c.tuilog.Error(result.GoResult.Output, "go")
 	}
 
-	if result.ImplResult.IsInstalled {
-		c.tuilog.Success("installed", "impl")
-	} else {
-		c.tuilog.Error(result.ImplResult.Output, "impl")
-	}
-
 	return nil
 }
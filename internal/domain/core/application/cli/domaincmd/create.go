@@ -8,6 +8,7 @@ import (
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -18,15 +19,19 @@ type DomainCreateCommand struct {
 	cmd            *cobra.Command
 	projectService ProjectService
 	tuilog         *tuilog.TUILog
+
+	// flags
+	flagName    *string
+	flagNoInput *bool
 }
 
-const newLong = `new command creates a domain under the "internal/domain/" directory.`
+const newLong = `new command creates a domain under the "internal/domain/" directory, or the domain directory in the layout section of the config.`
 
 func NewDomainCreateCommand(projectService ProjectService, tl *tuilog.TUILog) (*DomainCreateCommand, error) {
 	return &DomainCreateCommand{
 		cmd: &cobra.Command{
 			Use:     "new",
-			Example: "hexago domain new",
+			Example: "hexago domain new\nhexago domain new -n <domainname> --no-input",
 			Short:   "Create a domain",
 			Long:    newLong,
 		},
@@ -52,30 +57,40 @@ func (c *DomainCreateCommand) init() {
 		}
 		return nil
 	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago domain new -n <domainname>")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago domain new --no-input")
 }
 
 func (c *DomainCreateCommand) runner(cmd *cobra.Command, args []string) error {
-	var domainName string
-
-	if len(args) > 0 {
+	domainName := *c.flagName
+	if domainName == "" && len(args) > 0 {
 		domainName = args[0]
 	}
 
-	err := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title("What’s domain name?").
-				Placeholder("domainname").
-				Validate(c.projectService.ValidatePkgName).
-				Description("Domain name must be lowercase").
-				Value(&domainName),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
-		return fmt.Errorf("input domain name: %w", err)
+	if domainName == "" {
+		if *c.flagNoInput || !terminal.IsInteractive() {
+
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+
+			return fmt.Errorf("input domain name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s domain name?").
+					Placeholder("domainname").
+					Validate(c.projectService.ValidatePkgName).
+					Description("Domain name must be lowercase").
+					Value(&domainName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input domain name: %w", err)
+		}
 	}
 
-	err = c.projectService.CreateDomain(
+	err := c.projectService.CreateDomain(
 		cmd.Context(),
 		model.CreateDomainParams{
 			DomainName: domainName,
@@ -8,6 +8,11 @@ import (
 
 type ProjectService interface {
 	GetAllDomains(ctx context.Context) ([]string, error)
+	GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error)
 	ValidatePkgName(pkgName string) error
 	CreateDomain(ctx context.Context, params model.CreateDomainParams) error
+	PlanRemoval(ctx context.Context, params model.RemoveComponentParams) (*model.RemovalPlan, error)
+	RemoveComponent(ctx context.Context, plan *model.RemovalPlan) error
+	MoveComponent(ctx context.Context, params model.MoveComponentParams) (*model.MoveResult, error)
+	DisplayPath(name string) string
 }
@@ -2,12 +2,15 @@ package domaincmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -20,12 +23,13 @@ type DomainLSCommand struct {
 	projectService ProjectService
 
 	// flags
-	flagLine *bool
+	flagLine   *bool
+	flagOutput *string
 }
 
 const domainLSLong = `ls command lists domains in project.
 
-Domains are located under the "internal/domain/" directory.`
+Domains are located under the "internal/domain/" directory, or the domain directory in the layout section of the config.`
 
 func NewDomainLSCommand(projectService ProjectService, tl *tuilog.TUILog) (*DomainLSCommand, error) {
 	return &DomainLSCommand{
@@ -58,9 +62,30 @@ func (c *DomainLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago domain ls -l")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago domain ls -o json|yaml")
 }
 
 func (c *DomainLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	if format != output.FormatText {
+		components, err2 := c.projectService.GetAllComponents(cmd.Context(), model.KindDomain, "")
+		if err2 != nil {
+
+			c.tuilog.Error(err2.Error())
+
+			return fmt.Errorf("projectService.GetAllComponents: %w", err2)
+		}
+
+		return output.Print(os.Stdout, format, components)
+	}
+
 	domains, err := c.projectService.GetAllDomains(cmd.Context())
 	if err != nil {
 
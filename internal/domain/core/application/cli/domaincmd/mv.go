@@ -0,0 +1,88 @@
+package domaincmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*DomainMoveCommand)(nil)
+
+type DomainMoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+}
+
+const moveLong = `mv command renames the domain directory.
+
+Import paths and qualified references are rewritten in every package of the module.`
+
+func NewDomainMoveCommand(projectService ProjectService, tl *tuilog.TUILog) (*DomainMoveCommand, error) {
+	return &DomainMoveCommand{
+		cmd: &cobra.Command{
+			Use:     "mv <domainname> <newdomainname>",
+			Example: "hexago domain mv <domainname> <newdomainname>",
+			Short:   "Rename or move a domain",
+			Long:    moveLong,
+			Args:    cobra.ExactArgs(2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *DomainMoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *DomainMoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *DomainMoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+}
+
+func (c *DomainMoveCommand) runner(cmd *cobra.Command, args []string) error {
+	newName := args[1]
+
+	result, err := c.projectService.MoveComponent(cmd.Context(), model.MoveComponentParams{
+		Kind:    model.KindDomain,
+		Name:    args[0],
+		NewName: newName,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.MoveComponent: %w", err)
+	}
+
+	msg := fmt.Sprintf("%s -> %s", c.projectService.DisplayPath(result.From.Path), c.projectService.DisplayPath(result.To.Path))
+	if len(result.UpdatedFiles) > 0 {
+		msg += "\n\nUpdated files:\n" + strings.Join(lo.Map(result.UpdatedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n")
+	}
+
+	c.tuilog.Success(msg, "Domain Moved")
+
+	if len(result.SkippedFiles) > 0 {
+		c.tuilog.Warning("Files excluded by build constraints are not updated:\n" + strings.Join(lo.Map(result.SkippedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"))
+	}
+
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/huh"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
+	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -18,18 +21,24 @@ type EntryPointCreateCommand struct {
 	cmd            *cobra.Command
 	tuilog         *tuilog.TUILog
 	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagName    *string
+	flagNoInput *bool
 }
 
-func NewEntryPointCreateCommand(projectService ProjectService, tl *tuilog.TUILog) (*EntryPointCreateCommand, error) {
+func NewEntryPointCreateCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*EntryPointCreateCommand, error) {
 	return &EntryPointCreateCommand{
 		cmd: &cobra.Command{
 			Use:     "new",
-			Example: "hexago cmd new",
+			Example: "hexago cmd new\nhexago cmd new -n <entry-point-name> --no-input",
 			Short:   "Create an entry point",
 			Long:    `Create an entry point`,
 		},
 		tuilog:         tl,
 		projectService: projectService,
+		cfg:            cfg,
 	}, nil
 }
 
@@ -50,27 +59,43 @@ func (c *EntryPointCreateCommand) init() {
 		}
 		return nil
 	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago cmd new -n <entry-point-name>")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago cmd new --no-input")
 }
 
 func (c *EntryPointCreateCommand) runner(cmd *cobra.Command, args []string) error {
-	var cmdName string
+	err := c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
 
-	if len(args) > 0 {
+	cmdName := *c.flagName
+	if cmdName == "" && len(args) > 0 {
 		cmdName = args[0]
 	}
 
-	err := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title("What’s entry point name?").
-				Placeholder("entry-point-name").
-				Validate(c.projectService.ValidateEntryPointName).
-				Description("Entry point name must be kebab-case").
-				Value(&cmdName),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
-		return fmt.Errorf("input entry point name: %w", err)
+	if cmdName == "" {
+		if *c.flagNoInput || !terminal.IsInteractive() {
+
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+
+			return fmt.Errorf("input entry point name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s entry point name?").
+					Placeholder("entry-point-name").
+					Validate(c.projectService.ValidateEntryPointName).
+					Description("Entry point name must be kebab-case").
+					Value(&cmdName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input entry point name: %w", err)
+		}
 	}
 
 	epFile, err := c.projectService.CreateEntryPoint(
@@ -81,12 +106,16 @@ func (c *EntryPointCreateCommand) runner(cmd *cobra.Command, args []string) erro
 	)
 	if err != nil {
 
-		c.tuilog.Error(err.Error())
+		if err2, ok := lo.ErrorsAs[customerrors.ErrMissingRequirement](err); ok {
+			c.tuilog.Error(fmt.Sprintf("cobra entry points need %s in go.mod, run:\ngo get %s\nor use another entry point template", err2.Module, err2.Module))
+		} else {
+			c.tuilog.Error(err.Error())
+		}
 
 		return fmt.Errorf("projectService.CreateEntryPoint: %w", err)
 	}
 
-	c.tuilog.Success("Entry point created\n" + epFile)
+	c.tuilog.Success("Entry point created\n" + c.projectService.DisplayPath(epFile))
 
 	return nil
 }
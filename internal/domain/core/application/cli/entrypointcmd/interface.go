@@ -10,4 +10,9 @@ type ProjectService interface {
 	ValidateEntryPointName(entryPointName string) error
 	CreateEntryPoint(ctx context.Context, params model.CreateEntryPointParams) (string, error)
 	GetAllEntryPoints(ctx context.Context) ([]string, error)
+	GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error)
+	PlanRemoval(ctx context.Context, params model.RemoveComponentParams) (*model.RemovalPlan, error)
+	RemoveComponent(ctx context.Context, plan *model.RemovalPlan) error
+	MoveComponent(ctx context.Context, params model.MoveComponentParams) (*model.MoveResult, error)
+	DisplayPath(name string) string
 }
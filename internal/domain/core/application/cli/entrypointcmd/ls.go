@@ -2,12 +2,15 @@ package entrypointcmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -20,7 +23,8 @@ type EntryPointLSCommand struct {
 	projectService ProjectService
 
 	// flags
-	flagLine *bool
+	flagLine   *bool
+	flagOutput *string
 }
 
 func NewEntryPointLSCommand(projectService ProjectService, tl *tuilog.TUILog) (*EntryPointLSCommand, error) {
@@ -54,9 +58,30 @@ func (c *EntryPointLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago service ls -l")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago cmd ls -o json|yaml")
 }
 
 func (c *EntryPointLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	if format != output.FormatText {
+		components, err2 := c.projectService.GetAllComponents(cmd.Context(), model.KindEntryPoint, "")
+		if err2 != nil {
+
+			c.tuilog.Error(err2.Error())
+
+			return fmt.Errorf("projectService.GetAllComponents: %w", err2)
+		}
+
+		return output.Print(os.Stdout, format, components)
+	}
+
 	entryPoints, err := c.projectService.GetAllEntryPoints(cmd.Context())
 	if err != nil {
 
@@ -0,0 +1,88 @@
+package entrypointcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*EntryPointMoveCommand)(nil)
+
+type EntryPointMoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+}
+
+const moveLong = `mv command renames the entry point directory.
+
+Import paths and qualified references are rewritten in every package of the module.`
+
+func NewEntryPointMoveCommand(projectService ProjectService, tl *tuilog.TUILog) (*EntryPointMoveCommand, error) {
+	return &EntryPointMoveCommand{
+		cmd: &cobra.Command{
+			Use:     "mv <entry-point-name> <newentry-point-name>",
+			Example: "hexago cmd mv <entry-point-name> <newentry-point-name>",
+			Short:   "Rename or move an entry point",
+			Long:    moveLong,
+			Args:    cobra.ExactArgs(2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *EntryPointMoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *EntryPointMoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *EntryPointMoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+}
+
+func (c *EntryPointMoveCommand) runner(cmd *cobra.Command, args []string) error {
+	newName := args[1]
+
+	result, err := c.projectService.MoveComponent(cmd.Context(), model.MoveComponentParams{
+		Kind:    model.KindEntryPoint,
+		Name:    args[0],
+		NewName: newName,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.MoveComponent: %w", err)
+	}
+
+	msg := fmt.Sprintf("%s -> %s", c.projectService.DisplayPath(result.From.Path), c.projectService.DisplayPath(result.To.Path))
+	if len(result.UpdatedFiles) > 0 {
+		msg += "\n\nUpdated files:\n" + strings.Join(lo.Map(result.UpdatedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n")
+	}
+
+	c.tuilog.Success(msg, "Entry point Moved")
+
+	if len(result.SkippedFiles) > 0 {
+		c.tuilog.Warning("Files excluded by build constraints are not updated:\n" + strings.Join(lo.Map(result.SkippedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"))
+	}
+
+	return nil
+}
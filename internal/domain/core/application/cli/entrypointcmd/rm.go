@@ -0,0 +1,118 @@
+package entrypointcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*EntryPointRemoveCommand)(nil)
+
+type EntryPointRemoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagForce *bool
+}
+
+const removeLong = `rm command removes the entry point directory.
+
+The files to delete and the packages which still import the entry point are shown first.
+Nothing is deleted unless --force is given.
+Runner entries of the entry point are removed from .hexago/config.yaml too.`
+
+func NewEntryPointRemoveCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*EntryPointRemoveCommand, error) {
+	return &EntryPointRemoveCommand{
+		cmd: &cobra.Command{
+			Use:     "rm <entry-point-name>",
+			Example: "hexago cmd rm <entry-point-name>\nhexago cmd rm <entry-point-name> --force",
+			Short:   "Remove an entry point",
+			Long:    removeLong,
+			Args:    cobra.ExactArgs(1),
+		},
+		projectService: projectService,
+		cfg:            cfg,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *EntryPointRemoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *EntryPointRemoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *EntryPointRemoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagForce = c.cmd.Flags().Bool("force", false, "hexago cmd rm <entry-point-name> --force")
+}
+
+func (c *EntryPointRemoveCommand) runner(cmd *cobra.Command, args []string) error {
+	err := c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	plan, err := c.projectService.PlanRemoval(cmd.Context(), model.RemoveComponentParams{
+		Kind: model.KindEntryPoint,
+		Name: args[0],
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.PlanRemoval: %w", err)
+	}
+
+	c.tuilog.Info(strings.Join(lo.Map(plan.Files, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"), "Files to delete")
+
+	if len(plan.Runners) > 0 {
+		c.tuilog.Info("runners."+strings.Join(plan.Runners, "\nrunners."), "Config entries to remove")
+	}
+
+	if len(plan.Importers) > 0 {
+		lines := make([]string, 0, len(plan.Importers))
+		for _, importer := range plan.Importers {
+			lines = append(lines, fmt.Sprintf("%s (%s:%d)", importer.Package, c.projectService.DisplayPath(importer.File), importer.Line))
+		}
+		c.tuilog.Warning(strings.Join(lines, "\n"), "Still imported by")
+	}
+
+	if !*c.flagForce {
+		c.tuilog.Error("Nothing is deleted. Run again with --force to remove", "Entry point Not Removed")
+		return fmt.Errorf("remove entry point: --force is not given")
+	}
+
+	err = c.projectService.RemoveComponent(cmd.Context(), plan)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.RemoveComponent: %w", err)
+	}
+
+	c.tuilog.Success(c.projectService.DisplayPath(plan.Component.Path), "Entry point Removed")
+
+	return nil
+}
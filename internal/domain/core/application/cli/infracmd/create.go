@@ -1,8 +1,10 @@
 package infracmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/huh"
@@ -12,6 +14,7 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -23,13 +26,20 @@ type InfraCreateCommand struct {
 	tuilog         *tuilog.TUILog
 	projectService ProjectService
 	cfg            *config.Config
+
+	// flags
+	flagName    *string
+	flagPkg     *string
+	flagPorts   *[]string
+	flagAssert  *bool
+	flagNoInput *bool
 }
 
 func NewInfraCreateCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*InfraCreateCommand, error) {
 	return &InfraCreateCommand{
 		cmd: &cobra.Command{
 			Use:     "new",
-			Example: "hexago infra new",
+			Example: "hexago infra new\nhexago infra new -n <InfraName> --port <PortName> --assert --no-input",
 			Short:   "Create a infrastructure",
 			Long:    `Create a infrastructure`,
 		},
@@ -56,6 +66,11 @@ func (c *InfraCreateCommand) init() {
 		}
 		return nil
 	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago infra new -n <InfraName>")
+	c.flagPkg = c.cmd.Flags().StringP("pkg", "p", "", "hexago infra new -p <foldername>")
+	c.flagPorts = c.cmd.Flags().StringArray("port", nil, "hexago infra new --port <PortName> --port <PortName>")
+	c.flagAssert = c.cmd.Flags().Bool("assert", false, "hexago infra new --port <PortName> --assert")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago infra new --no-input")
 }
 
 func (c *InfraCreateCommand) runner(cmd *cobra.Command, args []string) error {
@@ -67,42 +82,62 @@ func (c *InfraCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cfg.Load: %w", err)
 	}
 
-	var infraName string
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
 
-	if len(args) > 0 {
+	infraName := *c.flagName
+	if infraName == "" && len(args) > 0 {
 		infraName = args[0]
 	}
 
-	err = huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title("What’s infrastructure name?").
-				Placeholder("InfraName").
-				Validate(c.projectService.ValidateInstanceName).
-				Description("Infrastructure name must be PascalCase").
-				Value(&infraName),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
-		return fmt.Errorf("input infrastructure name: %w", err)
+	if infraName == "" {
+		if !interactive {
+
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+
+			return fmt.Errorf("input infrastructure name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s infrastructure name?").
+					Placeholder("InfraName").
+					Validate(c.projectService.ValidateInstanceName).
+					Description("Infrastructure name must be PascalCase").
+					Value(&infraName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input infrastructure name: %w", err)
+		}
 	}
 
-	pkgName, err := c.selectPkgName(infraName)
-	if err != nil {
-		return fmt.Errorf("select pkg name: %w", err)
+	pkgName := *c.flagPkg
+	if pkgName == "" && interactive {
+		pkgName, err = c.selectPkgName(infraName)
+		if err != nil {
+			return fmt.Errorf("select pkg name: %w", err)
+		}
 	}
 
-	allPorts, err := c.projectService.GetAllPorts(cmd.Context())
-	if err != nil {
+	portInfo := &portInfo{
+		portNames:       *c.flagPorts,
+		assertInterface: *c.flagAssert,
+	}
 
-		c.tuilog.Error(err.Error())
+	if !cmd.Flags().Changed("port") && interactive {
+		allPorts, err2 := c.projectService.GetAllPorts(cmd.Context(), "")
+		if err2 != nil {
 
-		return fmt.Errorf("projectService.GetAllPorts: %w", err)
-	}
+			c.tuilog.Error(err2.Error())
 
-	portInfo, err := c.selectPort(allPorts, infraName)
-	if err != nil {
-		return fmt.Errorf("select port: %w", err)
+			return fmt.Errorf("projectService.GetAllPorts: %w", err2)
+		}
+
+		portInfo, err2 = c.selectPort(c.withExternalInterfaces(cmd.Context(), allPorts), infraName, cmd.Flags().Changed("assert"))
+		if err2 != nil {
+			return fmt.Errorf("select port: %w", err2)
+		}
 	}
 
 	infraFile, err := c.projectService.CreateInfrastructure(
@@ -110,7 +145,7 @@ func (c *InfraCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		model.CreateInfraParams{
 			StructName:      infraName,
 			PackageName:     pkgName,
-			PortParam:       portInfo.portName,
+			PortParams:      portInfo.portNames,
 			AssertInterface: portInfo.assertInterface,
 		},
 	)
@@ -133,7 +168,7 @@ func (c *InfraCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("projectService.CreateInfrastructure: %w", err)
 	}
 
-	c.tuilog.Success("infrastructure created\n" + infraFile)
+	c.tuilog.Success("infrastructure created\n" + c.projectService.DisplayPath(infraFile))
 
 	return nil
 }
@@ -161,53 +196,80 @@ func (c *InfraCreateCommand) selectPkgName(instanceName string) (string, error)
 	return pkgName, nil
 }
 
+// withExternalInterfaces appends the interfaces of the standard library and
+// the module dependencies to the ports. They are left out with a warning if
+// they can not be listed, since the ports can still be selected.
+func (c *InfraCreateCommand) withExternalInterfaces(ctx context.Context, allPorts []model.Port) []model.Port {
+	externalInterfaces, err := c.projectService.GetExternalInterfaces(ctx)
+	if err != nil {
+		c.tuilog.Warning("External interfaces can not be listed\n" + err.Error())
+		return allPorts
+	}
+
+	return slices.Concat(allPorts, externalInterfaces)
+}
+
 type portInfo struct {
-	portName        string
+	portNames       []string
 	assertInterface bool
 }
 
-func (c *InfraCreateCommand) selectPort(allPorts []string, instanceName string) (*portInfo, error) {
+func (c *InfraCreateCommand) selectPort(allPorts []model.Port, instanceName string, assertGiven bool) (*portInfo, error) {
 	if len(allPorts) == 0 {
 		return &portInfo{}, nil
 	}
 
-	selectPortList := []huh.Option[string]{
-		huh.NewOption[string]("Do not implement!", ""),
-	}
+	portNames := make(map[string]string, len(allPorts))
+
+	// ports are selected with their import paths, since a domain port may
+	// have the same name with a port in another directory
+	selectPortList := lo.Map(allPorts, func(p model.Port, _ int) huh.Option[string] {
+		portParam := p.ImportPath + "." + p.Name
+		portNames[portParam] = p.Package + "." + p.Name
+
+		label := p.Name + p.TypeParams
+		if p.Domain != "" {
+			label += " (" + p.Domain + ")"
+		}
+
+		// external interfaces are not in a file of the project
+		if p.Path == "" {
+			label = portParam + p.TypeParams
+		}
 
-	selectPortList = append(selectPortList, lo.Map(allPorts, func(d string, _ int) huh.Option[string] {
-		return huh.NewOption(d, d)
-	})...)
+		return huh.NewOption(label, portParam)
+	})
 
-	var portName string
+	var selectedPorts []string
 
 	err := huh.NewForm(
 		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Select a port.").
+			huh.NewMultiSelect[string]().
+				Title("Select the ports to implement.").
+				Description("Select none to implement nothing, press / to search").
+				Filterable(true).
+				Height(15).
 				Options(
 					selectPortList...,
 				).
-				Value(&portName),
+				Value(&selectedPorts),
 		).WithShowHelp(true),
 	).Run()
 	if err != nil {
-		return nil, fmt.Errorf("select a port: %w", err)
+		return nil, fmt.Errorf("select ports: %w", err)
 	}
 
-	assertInterface := false
+	assertInterface := *c.flagAssert
 
-	if portName != "" {
+	if len(selectedPorts) > 0 && !assertGiven {
 		err = huh.NewForm(
 			huh.NewGroup(
 				huh.NewConfirm().
-					Title("Do you want to assert port?").
+					Title("Do you want to assert ports?").
 					Description(
-						fmt.Sprintf(
-							"var _ port.%s = (*%s)(nil)",
-							portName,
-							instanceName,
-						),
+						strings.Join(lo.Map(selectedPorts, func(portParam string, _ int) string {
+							return fmt.Sprintf("var _ %s = (*%s)(nil)", portNames[portParam], instanceName)
+						}), "\n"),
 					).
 					Affirmative("Yes").
 					Negative("No").
@@ -220,7 +282,7 @@ func (c *InfraCreateCommand) selectPort(allPorts []string, instanceName string)
 	}
 
 	return &portInfo{
-		portName:        portName,
+		portNames:       selectedPorts,
 		assertInterface: assertInterface,
 	}, nil
 }
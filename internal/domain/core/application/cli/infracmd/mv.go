@@ -0,0 +1,96 @@
+package infracmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*InfraMoveCommand)(nil)
+
+type InfraMoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagStruct *string
+}
+
+const moveLong = `mv command renames the infrastructure directory and package clause and renames the struct type with --struct.
+
+Import paths and qualified references are rewritten in every package of the module.`
+
+func NewInfraMoveCommand(projectService ProjectService, tl *tuilog.TUILog) (*InfraMoveCommand, error) {
+	return &InfraMoveCommand{
+		cmd: &cobra.Command{
+			Use:     "mv <infraname> [newinfraname]",
+			Example: "hexago infra mv <infraname> <newinfraname>\nhexago infra mv <infraname> <newinfraname> --struct <NewStructName>",
+			Short:   "Rename or move an infrastructure",
+			Long:    moveLong,
+			Args:    cobra.RangeArgs(1, 2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *InfraMoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *InfraMoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *InfraMoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagStruct = c.cmd.Flags().String("struct", "", "hexago infra mv <infraname> <newinfraname> --struct <NewStructName>")
+}
+
+func (c *InfraMoveCommand) runner(cmd *cobra.Command, args []string) error {
+	var newName string
+	if len(args) > 1 {
+		newName = args[1]
+	}
+
+	result, err := c.projectService.MoveComponent(cmd.Context(), model.MoveComponentParams{
+		Kind:          model.KindInfrastructure,
+		Name:          args[0],
+		NewName:       newName,
+		NewStructName: *c.flagStruct,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.MoveComponent: %w", err)
+	}
+
+	msg := fmt.Sprintf("%s -> %s", c.projectService.DisplayPath(result.From.Path), c.projectService.DisplayPath(result.To.Path))
+	if len(result.UpdatedFiles) > 0 {
+		msg += "\n\nUpdated files:\n" + strings.Join(lo.Map(result.UpdatedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n")
+	}
+
+	c.tuilog.Success(msg, "Infrastructure Moved")
+
+	if len(result.SkippedFiles) > 0 {
+		c.tuilog.Warning("Files excluded by build constraints are not updated:\n" + strings.Join(lo.Map(result.SkippedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package infracmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*InfraRemoveCommand)(nil)
+
+type InfraRemoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagForce *bool
+}
+
+const removeLong = `rm command removes the infrastructure directory.
+
+The files to delete and the packages which still import the infrastructure are shown first.
+Nothing is deleted unless --force is given.`
+
+func NewInfraRemoveCommand(projectService ProjectService, tl *tuilog.TUILog) (*InfraRemoveCommand, error) {
+	return &InfraRemoveCommand{
+		cmd: &cobra.Command{
+			Use:     "rm <infraname>",
+			Example: "hexago infra rm <infraname>\nhexago infra rm <infraname> --force",
+			Short:   "Remove an infrastructure",
+			Long:    removeLong,
+			Args:    cobra.ExactArgs(1),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *InfraRemoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *InfraRemoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *InfraRemoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagForce = c.cmd.Flags().Bool("force", false, "hexago infra rm <infraname> --force")
+}
+
+func (c *InfraRemoveCommand) runner(cmd *cobra.Command, args []string) error {
+	plan, err := c.projectService.PlanRemoval(cmd.Context(), model.RemoveComponentParams{
+		Kind: model.KindInfrastructure,
+		Name: args[0],
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.PlanRemoval: %w", err)
+	}
+
+	c.tuilog.Info(strings.Join(lo.Map(plan.Files, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"), "Files to delete")
+
+	if len(plan.Runners) > 0 {
+		c.tuilog.Info("runners."+strings.Join(plan.Runners, "\nrunners."), "Config entries to remove")
+	}
+
+	if len(plan.Importers) > 0 {
+		lines := make([]string, 0, len(plan.Importers))
+		for _, importer := range plan.Importers {
+			lines = append(lines, fmt.Sprintf("%s (%s:%d)", importer.Package, c.projectService.DisplayPath(importer.File), importer.Line))
+		}
+		c.tuilog.Warning(strings.Join(lines, "\n"), "Still imported by")
+	}
+
+	if !*c.flagForce {
+		c.tuilog.Error("Nothing is deleted. Run again with --force to remove", "Infrastructure Not Removed")
+		return fmt.Errorf("remove infrastructure: --force is not given")
+	}
+
+	err = c.projectService.RemoveComponent(cmd.Context(), plan)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.RemoveComponent: %w", err)
+	}
+
+	c.tuilog.Success(c.projectService.DisplayPath(plan.Component.Path), "Infrastructure Removed")
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -22,6 +23,10 @@ type InitCommand struct {
 	cmd            *cobra.Command
 	tuilog         *tuilog.TUILog
 	projectService ProjectService
+
+	// flags
+	flagModule  *string
+	flagNoInput *bool
 }
 
 const initLongDescription = `init command initialize a hexagonal Go project.
@@ -31,6 +36,7 @@ In the other side, it initialize traditional Go hexagonal folder structure.
 Requires empty folder to init new project.`
 
 const initExamples = `hexago init <project-name> (prompts module name interactively)
+hexago init <project-name> --module <module-name> --no-input
 `
 
 func NewInitCommand(projectService ProjectService, tl *tuilog.TUILog) (*InitCommand, error) {
@@ -64,52 +70,62 @@ func (c *InitCommand) init() {
 		}
 		return nil
 	}
+	c.flagModule = c.cmd.Flags().StringP("module", "m", "", "hexago init <project-name> -m <module-name>")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago init <project-name> --no-input")
 }
 
 func (c *InitCommand) runner(cmd *cobra.Command, args []string) error {
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
+	moduleGiven := c.cmd.Flags().Changed("module")
+
 	createModule := true
 
-	existingModuleName, err := c.projectService.GetModuleName(filepath.Join(args[0], "go.mod"))
-	if err == nil {
-		err = huh.NewForm(
-			huh.NewGroup(
-				huh.NewConfirm().
-					Title("Do you want to overwrite the existing module?").
-					Description("existing -> " + existingModuleName).
-					Affirmative("Yes").
-					Negative("No").
-					Value(&createModule),
-			).WithShowHelp(true),
-		).Run()
-		if err != nil {
-			return fmt.Errorf("confirm module create: %w", err)
-		}
+	// the project root may be discovered above the working directory, so the
+	// project directory is resolved against the working directory here
+	projectDir, err := filepath.Abs(args[0])
+	if err != nil {
+		return fmt.Errorf("filepath: abs: %w", err)
 	}
 
-	var moduleName string
-	if createModule {
-		defaultModuleName := filepath.Base(args[0])
-
-		if defaultModuleName == "." {
-			abs, err2 := filepath.Abs(defaultModuleName)
-			if err2 != nil {
-				return fmt.Errorf("filepath: abs: %w", err2)
+	existingModuleName, err := c.projectService.GetModuleName(filepath.Join(projectDir, "go.mod"))
+	if err == nil && !moduleGiven {
+		// without a prompt, the existing module is kept as is
+		createModule = interactive
+
+		if interactive {
+			err = huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title("Do you want to overwrite the existing module?").
+						Description("existing -> " + existingModuleName).
+						Affirmative("Yes").
+						Negative("No").
+						Value(&createModule),
+				).WithShowHelp(true),
+			).Run()
+			if err != nil {
+				return fmt.Errorf("confirm module create: %w", err)
 			}
-
-			defaultModuleName = filepath.Base(abs)
 		}
+	}
 
-		err = huh.NewForm(
-			huh.NewGroup(
-				huh.NewInput().
-					Title("What’s module name?").
-					Placeholder(defaultModuleName).
-					Description("If you leave it blank, it will have the same name\nas the project directory").
-					Value(&moduleName),
-			).WithShowHelp(true),
-		).Run()
-		if err != nil {
-			return fmt.Errorf("input module name: %w", err)
+	moduleName := *c.flagModule
+	if createModule && moduleName == "" {
+		defaultModuleName := filepath.Base(projectDir)
+
+		if interactive {
+			err = huh.NewForm(
+				huh.NewGroup(
+					huh.NewInput().
+						Title("What’s module name?").
+						Placeholder(defaultModuleName).
+						Description("If you leave it blank, it will have the same name\nas the project directory").
+						Value(&moduleName),
+				).WithShowHelp(true),
+			).Run()
+			if err != nil {
+				return fmt.Errorf("input module name: %w", err)
+			}
 		}
 
 		if strings.TrimSpace(moduleName) == "" {
@@ -118,7 +134,7 @@ func (c *InitCommand) runner(cmd *cobra.Command, args []string) error {
 	}
 
 	err = c.projectService.InitNewProject(cmd.Context(), model.InitNewProjectParams{
-		ProjectDirectory: args[0],
+		ProjectDirectory: projectDir,
 		ModuleName:       moduleName,
 		CreateModule:     createModule,
 	})
@@ -0,0 +1,377 @@
+package kindcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*KindCreateCommand)(nil)
+
+type KindCreateCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+	kind           model.CustomKind
+
+	// flags
+	flagName    *string
+	flagPkg     *string
+	flagDomain  *string
+	flagPorts   *[]string
+	flagAssert  *bool
+	flagNoInput *bool
+}
+
+func NewKindCreateCommand(projectService ProjectService, cfg *config.Config, kind model.CustomKind, tl *tuilog.TUILog) (*KindCreateCommand, error) {
+	example := fmt.Sprintf("hexago %s new\nhexago %s new -n <Name> --port <PortName> --assert --no-input", kind.Name, kind.Name)
+	if kind.PerDomain {
+		example = fmt.Sprintf("hexago %s new\nhexago %s new -n <Name> -d <domainname> --port <PortName> --assert --no-input", kind.Name, kind.Name)
+	}
+
+	long := fmt.Sprintf("new command creates a %s under the %q directory", kind.Name, kind.Dir)
+	if kind.PerDomain {
+		long += " of the domain"
+	}
+	long += "."
+	if kind.Suffix != "" {
+		long += fmt.Sprintf("\n\nThe %q suffix is appended to the name if it is missing.", kind.Suffix)
+	}
+
+	return &KindCreateCommand{
+		cmd: &cobra.Command{
+			Use:     "new",
+			Example: example,
+			Short:   "Create a " + kind.Name,
+			Long:    long,
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		cfg:            cfg,
+		kind:           kind,
+	}, nil
+}
+
+func (c *KindCreateCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *KindCreateCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *KindCreateCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", fmt.Sprintf("hexago %s new -n <Name>", c.kind.Name))
+	c.flagPkg = c.cmd.Flags().StringP("pkg", "p", "", fmt.Sprintf("hexago %s new -p <foldername>", c.kind.Name))
+	if c.kind.PerDomain {
+		c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", fmt.Sprintf("hexago %s new -d <domainname>", c.kind.Name))
+	} else {
+		c.flagDomain = new(string)
+	}
+	c.flagPorts = c.cmd.Flags().StringArray("port", nil, fmt.Sprintf("hexago %s new --port <PortName> --port <PortName>", c.kind.Name))
+	c.flagAssert = c.cmd.Flags().Bool("assert", false, fmt.Sprintf("hexago %s new --port <PortName> --assert", c.kind.Name))
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, fmt.Sprintf("hexago %s new --no-input", c.kind.Name))
+}
+
+func (c *KindCreateCommand) runner(cmd *cobra.Command, args []string) error {
+	err := c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
+
+	domainName := *c.flagDomain
+	if c.kind.PerDomain {
+		domainName, err = c.selectDomain(cmd, interactive)
+		if err != nil {
+			return fmt.Errorf("select domain: %w", err)
+		}
+	}
+
+	name := *c.flagName
+	if name == "" && len(args) > 0 {
+		name = args[0]
+	}
+
+	if name == "" {
+		if !interactive {
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+			return fmt.Errorf("input %s name: %w", c.kind.Name, customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title(fmt.Sprintf("What’s %s name?", c.kind.Name)).
+					Placeholder("Name" + c.kind.Suffix).
+					Validate(c.projectService.ValidateInstanceName).
+					Description("Name must be PascalCase").
+					Value(&name),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input %s name: %w", c.kind.Name, err)
+		}
+	}
+
+	pkgName := *c.flagPkg
+	if pkgName == "" && interactive {
+		pkgName, err = c.selectPkgName(strings.TrimSuffix(name, c.kind.Suffix))
+		if err != nil {
+			return fmt.Errorf("select pkg name: %w", err)
+		}
+	}
+
+	portInfo := &portInfo{
+		portNames:       *c.flagPorts,
+		assertInterface: *c.flagAssert,
+	}
+
+	if !cmd.Flags().Changed("port") && interactive {
+		allPorts, err2 := c.projectService.GetAllPorts(cmd.Context(), domainName)
+		if err2 != nil {
+
+			c.tuilog.Error(err2.Error())
+
+			return fmt.Errorf("projectService.GetAllPorts: %w", err2)
+		}
+
+		portInfo, err2 = c.selectPort(c.withExternalInterfaces(cmd.Context(), allPorts), name, cmd.Flags().Changed("assert"))
+		if err2 != nil {
+			return fmt.Errorf("select port: %w", err2)
+		}
+	}
+
+	componentFile, err := c.projectService.CreateCustomComponent(
+		cmd.Context(),
+		model.CreateCustomComponentParams{
+			Kind:            c.kind.Name,
+			TargetDomain:    domainName,
+			StructName:      name,
+			PackageName:     pkgName,
+			PortParams:      portInfo.portNames,
+			AssertInterface: portInfo.assertInterface,
+		},
+	)
+	if err != nil {
+
+		if errors.Is(err, customerrors.ErrInvalidInstanceName) {
+			c.tuilog.Error("Name not valid\nMust be <PascalCase>")
+		} else if errors.Is(err, customerrors.ErrInvalidPkgName) {
+			c.tuilog.Error("Folder name not valid\nMust be <lowercase>")
+		} else if errors.Is(err, customerrors.ErrDomainNotFound) {
+			c.tuilog.Error("Domain not found")
+		} else if errors.Is(err, customerrors.ErrTemplateCanNotParsed) {
+			c.tuilog.Error("Template can not parsed")
+		} else if err2, ok1 := lo.ErrorsAs[customerrors.ErrTemplateCanNotExecute](err); ok1 {
+			c.tuilog.Error("Template can not execute\n" + err2.Message)
+		} else if err2, ok2 := lo.ErrorsAs[customerrors.ErrFormatGoFile](err); ok2 {
+			c.tuilog.Error("Go file doesn't formatted\n" + err2.Message)
+		} else {
+			c.tuilog.Error(err.Error())
+		}
+
+		return fmt.Errorf("projectService.CreateCustomComponent: %w", err)
+	}
+
+	c.tuilog.Success(fmt.Sprintf("%s%s created\n%s", strings.ToUpper(c.kind.Name[:1]), c.kind.Name[1:], c.projectService.DisplayPath(componentFile)))
+
+	return nil
+}
+
+func (c *KindCreateCommand) selectDomain(cmd *cobra.Command, interactive bool) (string, error) {
+	domains, err := c.projectService.GetAllDomains(cmd.Context())
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return "", fmt.Errorf("projectService.GetAllDomains: %w", err)
+	}
+
+	if len(domains) == 0 {
+
+		c.tuilog.Error("No domains found.\nA domain needs to be created first")
+
+		return "", fmt.Errorf("No domains found.\nA domain needs to be created first")
+	}
+
+	domainName := *c.flagDomain
+	switch {
+	case domainName != "":
+		if !slices.Contains(domains, domainName) {
+			c.tuilog.Error("Domain not found: " + domainName)
+			return "", fmt.Errorf("domain not found: %w (%s)", customerrors.ErrDomainNotFound, domainName)
+		}
+	case len(domains) == 1:
+		domainName = domains[0]
+	case !interactive:
+		c.tuilog.Error(customerrors.ErrMissingInput{Flag: "domain"}.Error())
+		return "", fmt.Errorf("select a domain: %w", customerrors.ErrMissingInput{Flag: "domain"})
+	default:
+		selectList := lo.Map(domains, func(d string, _ int) huh.Option[string] {
+			return huh.NewOption(d, d)
+		})
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[string]().
+					Title("Select a domain.").
+					Options(
+						selectList...,
+					).
+					Value(&domainName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+
+			c.tuilog.Error("Select a domain: " + err.Error())
+
+			return "", fmt.Errorf("select a domain: %w", err)
+		}
+	}
+
+	return domainName, nil
+}
+
+func (c *KindCreateCommand) selectPkgName(instanceName string) (string, error) {
+	var pkgName string
+	err := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("What’s folder (pkg) name?").
+				Placeholder(strings.ToLower(instanceName)).
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					return c.projectService.ValidatePkgName(s)
+				}).
+				Description("Folder name must be lowercase").
+				Value(&pkgName),
+		).WithShowHelp(true),
+	).Run()
+	if err != nil {
+		return "", fmt.Errorf("input pkg name: %w", err)
+	}
+	return pkgName, nil
+}
+
+// withExternalInterfaces appends the interfaces of the standard library and
+// the module dependencies to the ports. They are left out with a warning if
+// they can not be listed, since the ports can still be selected.
+func (c *KindCreateCommand) withExternalInterfaces(ctx context.Context, allPorts []model.Port) []model.Port {
+	externalInterfaces, err := c.projectService.GetExternalInterfaces(ctx)
+	if err != nil {
+		c.tuilog.Warning("External interfaces can not be listed\n" + err.Error())
+		return allPorts
+	}
+
+	return slices.Concat(allPorts, externalInterfaces)
+}
+
+type portInfo struct {
+	portNames       []string
+	assertInterface bool
+}
+
+func (c *KindCreateCommand) selectPort(allPorts []model.Port, instanceName string, assertGiven bool) (*portInfo, error) {
+	if len(allPorts) == 0 {
+		return &portInfo{}, nil
+	}
+
+	portNames := make(map[string]string, len(allPorts))
+
+	// ports are selected with their import paths, since a domain port may
+	// have the same name with a port in another directory
+	selectPortList := lo.Map(allPorts, func(p model.Port, _ int) huh.Option[string] {
+		portParam := p.ImportPath + "." + p.Name
+		portNames[portParam] = p.Package + "." + p.Name
+
+		label := p.Name + p.TypeParams
+		if p.Domain != "" {
+			label += " (" + p.Domain + ")"
+		}
+
+		// external interfaces are not in a file of the project
+		if p.Path == "" {
+			label = portParam + p.TypeParams
+		}
+
+		return huh.NewOption(label, portParam)
+	})
+
+	var selectedPorts []string
+
+	err := huh.NewForm(
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Select the ports to implement.").
+				Description("Select none to implement nothing, press / to search").
+				Filterable(true).
+				Height(15).
+				Options(
+					selectPortList...,
+				).
+				Value(&selectedPorts),
+		).WithShowHelp(true),
+	).Run()
+	if err != nil {
+		return nil, fmt.Errorf("select ports: %w", err)
+	}
+
+	assertInterface := *c.flagAssert
+
+	if len(selectedPorts) > 0 && !assertGiven {
+		structName := instanceName
+		if !strings.HasSuffix(structName, c.kind.Suffix) {
+			structName += c.kind.Suffix
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title("Do you want to assert ports?").
+					Description(
+						strings.Join(lo.Map(selectedPorts, func(portParam string, _ int) string {
+							return fmt.Sprintf("var _ %s = (*%s)(nil)", portNames[portParam], structName)
+						}), "\n"),
+					).
+					Affirmative("Yes").
+					Negative("No").
+					Value(&assertInterface),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return nil, fmt.Errorf("confirm assert port: %w", err)
+		}
+	}
+
+	return &portInfo{
+		portNames:       selectedPorts,
+		assertInterface: assertInterface,
+	}, nil
+}
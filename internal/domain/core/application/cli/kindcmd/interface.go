@@ -0,0 +1,18 @@
+package kindcmd
+
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+type ProjectService interface {
+	GetAllDomains(ctx context.Context) ([]string, error)
+	ValidateInstanceName(instanceName string) error
+	ValidatePkgName(pkgName string) error
+	GetAllPorts(ctx context.Context, targetDomain string) ([]model.Port, error)
+	GetExternalInterfaces(ctx context.Context) ([]model.Port, error)
+	CreateCustomComponent(ctx context.Context, params model.CreateCustomComponentParams) (string, error)
+	GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error)
+	DisplayPath(name string) string
+}
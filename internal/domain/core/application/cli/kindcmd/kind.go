@@ -0,0 +1,57 @@
+package kindcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*KindCommand)(nil)
+
+type KindCommand struct {
+	cmd *cobra.Command
+}
+
+func NewKindCommand(kind model.CustomKind) (*KindCommand, error) {
+	return &KindCommand{
+		cmd: &cobra.Command{
+			Use:     kind.Name,
+			Example: "hexago " + kind.Name,
+			Short:   fmt.Sprintf("%s processes", kind.Name),
+			Long:    fmt.Sprintf("%s processes\n\n%s is a component kind declared in the config.", kind.Name, kind.Name),
+		},
+	}, nil
+}
+
+func (c *KindCommand) Command() *cobra.Command {
+	return c.cmd
+}
+
+func (c *KindCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+// Register adds the kind command to the root command. A kind named like a
+// command or an alias of the root command would shadow it, so the reserved
+// names are taken from the root command, including the help and completion
+// commands cobra adds on execution.
+func (c *KindCommand) Register(rootCmd *cobra.Command) error {
+	rootCmd.InitDefaultHelpCmd()
+	rootCmd.InitDefaultCompletionCmd()
+
+	name := c.cmd.Name()
+
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return customerrors.ErrInvalidKind{Kind: name, Reason: fmt.Sprintf("name is reserved by the %s command", cmd.Name())}
+		}
+	}
+
+	rootCmd.AddCommand(c.cmd)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package kindcmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/appcmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/application/cli/lintcmd"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		kind string
+	}
+	type want struct {
+		err require.ErrorAssertionFunc
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "free name",
+			args: args{kind: "repository"},
+			want: want{err: require.NoError},
+		},
+		{
+			name: "command name",
+			args: args{kind: "app"},
+			want: want{err: requireReserved},
+		},
+		{
+			name: "app alias",
+			args: args{kind: "a"},
+			want: want{err: requireReserved},
+		},
+		{
+			name: "lint alias",
+			args: args{kind: "check"},
+			want: want{err: requireReserved},
+		},
+		{
+			name: "help command",
+			args: args{kind: "help"},
+			want: want{err: requireReserved},
+		},
+		{
+			name: "completion command",
+			args: args{kind: "completion"},
+			want: want{err: requireReserved},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			appCmd, err := appcmd.NewAppCommand()
+			require.NoError(t, err)
+
+			lintCmd, err := lintcmd.NewLintCommand(nil, nil, nil)
+			require.NoError(t, err)
+
+			rootCmd := &cobra.Command{Use: "hexago"}
+			rootCmd.AddCommand(appCmd.Command(), lintCmd.Command())
+
+			kindCmd, err := NewKindCommand(model.CustomKind{Name: tt.args.kind})
+			require.NoError(t, err)
+
+			err = kindCmd.Register(rootCmd)
+			tt.want.err(t, err)
+
+			registered := rootCmd.Commands()
+			if err == nil {
+				require.Contains(t, registered, kindCmd.Command())
+			} else {
+				require.NotContains(t, registered, kindCmd.Command())
+			}
+		})
+	}
+}
+
+func requireReserved(t require.TestingT, err error, _ ...any) {
+	target := customerrors.ErrInvalidKind{}
+	require.ErrorAs(t, err, &target)
+	require.Contains(t, target.Reason, "reserved")
+}
@@ -0,0 +1,164 @@
+package kindcmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*KindLSCommand)(nil)
+
+type KindLSCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	kind           model.CustomKind
+
+	// flags
+	flagLine   *bool
+	flagOutput *string
+	flagDomain *string
+}
+
+func NewKindLSCommand(projectService ProjectService, kind model.CustomKind, tl *tuilog.TUILog) (*KindLSCommand, error) {
+	example := fmt.Sprintf("hexago %s ls", kind.Name)
+	if kind.PerDomain {
+		example = fmt.Sprintf("hexago %s ls -d <domainname>\nhexago %s ls (select domain interatively)", kind.Name, kind.Name)
+	}
+
+	return &KindLSCommand{
+		cmd: &cobra.Command{
+			Use:     "ls",
+			Example: example,
+			Short:   "List " + kind.Name + " components",
+			Long:    "List " + kind.Name + " components",
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		kind:           kind,
+	}, nil
+}
+
+func (c *KindLSCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *KindLSCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *KindLSCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, fmt.Sprintf("hexago %s ls -l", c.kind.Name))
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", fmt.Sprintf("hexago %s ls -o json|yaml", c.kind.Name))
+	if c.kind.PerDomain {
+		c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", fmt.Sprintf("hexago %s ls -d <domainname>", c.kind.Name))
+	} else {
+		c.flagDomain = new(string)
+	}
+}
+
+func (c *KindLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	if c.kind.PerDomain {
+		err = c.selectDomain(cmd, format)
+		if err != nil {
+			return fmt.Errorf("select domain: %w", err)
+		}
+	}
+
+	components, err := c.projectService.GetAllComponents(cmd.Context(), model.ComponentKind(c.kind.Name), *c.flagDomain)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("projectService.GetAllComponents: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, components)
+	}
+
+	names := lo.Map(components, func(component model.Component, _ int) string {
+		if *c.flagDomain == "*" {
+			return component.Domain + ":" + component.Name
+		}
+		return component.Name
+	})
+
+	separator := lo.Ternary(*c.flagLine, "\n", " ")
+
+	fmt.Println(strings.Join(names, separator))
+
+	return nil
+}
+
+func (c *KindLSCommand) selectDomain(cmd *cobra.Command, format output.Format) error {
+	domains, err := c.projectService.GetAllDomains(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("projectService.GetAllDomains: %w", err)
+	}
+
+	if len(domains) == 0 {
+		c.tuilog.Error("No domains found.\nA domain needs to be created first")
+		return fmt.Errorf("No domains found.\nA domain needs to be created first")
+	}
+
+	switch {
+	case *c.flagDomain != "":
+		if *c.flagDomain != "*" && !slices.Contains(domains, *c.flagDomain) {
+			c.tuilog.Error("Domain not found: ", *c.flagDomain)
+			return fmt.Errorf("domain not found: %s", *c.flagDomain)
+		}
+	case len(domains) == 1:
+		*c.flagDomain = domains[0]
+	case format != output.FormatText || !terminal.IsInteractive():
+		*c.flagDomain = "*"
+	default:
+		selectList := []huh.Option[string]{
+			huh.NewOption("* (All Domains)", "*"),
+		}
+
+		selectList = append(selectList, lo.Map(domains, func(d string, _ int) huh.Option[string] {
+			return huh.NewOption(d, d)
+		})...)
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[string]().
+					Title("Select a domain.").
+					Options(
+						selectList...,
+					).
+					Value(c.flagDomain),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("select a domain: %w", err)
+		}
+	}
+
+	return nil
+}
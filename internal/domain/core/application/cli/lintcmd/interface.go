@@ -0,0 +1,12 @@
+package lintcmd
+
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+type ProjectService interface {
+	Lint(ctx context.Context) ([]model.LintViolation, error)
+	DisplayPath(name string) string
+}
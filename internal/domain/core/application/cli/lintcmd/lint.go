@@ -0,0 +1,112 @@
+package lintcmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*LintCommand)(nil)
+
+type LintCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagOutput *string
+}
+
+const lintLong = `lint command checks the imports of every package against the hexagonal layer rules.
+
+Rules can be declared under the "lint" section of the ".hexago/config.yaml" file.
+If there is no rule declared, the default rules are used.
+
+Exits with a non-zero code if any violation is found.`
+
+func NewLintCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*LintCommand, error) {
+	return &LintCommand{
+		cmd: &cobra.Command{
+			Use:     "lint",
+			Aliases: []string{"check"},
+			Example: "hexago lint\nhexago lint -o json",
+			Short:   "Check hexagonal dependency rules",
+			Long:    lintLong,
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		cfg:            cfg,
+	}, nil
+}
+
+func (c *LintCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *LintCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *LintCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago lint -o json|yaml")
+}
+
+func (c *LintCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	err = c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	violations, err := c.projectService.Lint(cmd.Context())
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("projectService.Lint: %w", err)
+	}
+
+	if format != output.FormatText {
+		err = output.Print(os.Stdout, format, violations)
+		if err != nil {
+			return fmt.Errorf("output.Print: %w", err)
+		}
+	} else {
+		for _, v := range violations {
+			fmt.Printf("%s:%d:%d: %s imports %q (%s)\n", c.projectService.DisplayPath(v.File), v.Line, v.Column, v.Package, v.Import, v.Rule)
+		}
+	}
+
+	if len(violations) > 0 {
+		if format == output.FormatText {
+			c.tuilog.Error(fmt.Sprintf("%d violation(s) found", len(violations)), "Lint")
+		}
+		return fmt.Errorf("%d violation(s) found", len(violations))
+	}
+
+	if format == output.FormatText {
+		c.tuilog.Success("No violations found", "Lint")
+	}
+
+	return nil
+}
@@ -1,9 +1,11 @@
 package packagecmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/huh"
@@ -13,6 +15,7 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -24,13 +27,21 @@ type PackageCreateCommand struct {
 	tuilog         *tuilog.TUILog
 	projectService ProjectService
 	cfg            *config.Config
+
+	// flags
+	flagName    *string
+	flagPkg     *string
+	flagPorts   *[]string
+	flagAssert  *bool
+	flagGlobal  *bool
+	flagNoInput *bool
 }
 
 func NewPackageCreateCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*PackageCreateCommand, error) {
 	return &PackageCreateCommand{
 		cmd: &cobra.Command{
 			Use:     "new",
-			Example: "hexago pkg new",
+			Example: "hexago pkg new\nhexago pkg new -n <PackageName> -g --port <PortName> --assert --no-input",
 			Short:   "Create a package",
 			Long:    `Create a package`,
 		},
@@ -57,6 +68,12 @@ func (c *PackageCreateCommand) init() {
 		}
 		return nil
 	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago pkg new -n <PackageName>")
+	c.flagPkg = c.cmd.Flags().StringP("pkg", "p", "", "hexago pkg new -p <foldername>")
+	c.flagPorts = c.cmd.Flags().StringArray("port", nil, "hexago pkg new --port <PortName> --port <PortName>")
+	c.flagAssert = c.cmd.Flags().Bool("assert", false, "hexago pkg new --port <PortName> --assert")
+	c.flagGlobal = c.cmd.Flags().BoolP("global", "g", false, "hexago pkg new -g")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago pkg new --no-input")
 }
 
 func (c *PackageCreateCommand) runner(cmd *cobra.Command, args []string) error {
@@ -68,66 +85,88 @@ func (c *PackageCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cfg.Load: %w", err)
 	}
 
-	var packageName string
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
 
-	if len(args) > 0 {
+	packageName := *c.flagName
+	if packageName == "" && len(args) > 0 {
 		packageName = args[0]
 	}
 
-	err = huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title("What’s package name?").
-				Placeholder("PackageName").
-				Validate(c.projectService.ValidateInstanceName).
-				Description("Package name must be PascalCase").
-				Value(&packageName),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
-		return fmt.Errorf("input package name: %w", err)
+	if packageName == "" {
+		if !interactive {
+
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+
+			return fmt.Errorf("input package name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s package name?").
+					Placeholder("PackageName").
+					Validate(c.projectService.ValidateInstanceName).
+					Description("Package name must be PascalCase").
+					Value(&packageName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input package name: %w", err)
+		}
 	}
 
-	pkgName, err := c.selectPkgName(packageName)
-	if err != nil {
-		return fmt.Errorf("select pkg name: %w", err)
+	pkgName := *c.flagPkg
+	if pkgName == "" && interactive {
+		pkgName, err = c.selectPkgName(packageName)
+		if err != nil {
+			return fmt.Errorf("select pkg name: %w", err)
+		}
 	}
 
-	allPorts, err := c.projectService.GetAllPorts(cmd.Context())
-	if err != nil {
+	portInfo := &portInfo{
+		portNames:       *c.flagPorts,
+		assertInterface: *c.flagAssert,
+	}
 
-		c.tuilog.Error(err.Error())
+	if !cmd.Flags().Changed("port") && interactive {
+		allPorts, err2 := c.projectService.GetAllPorts(cmd.Context(), "")
+		if err2 != nil {
 
-		return fmt.Errorf("projectService.GetAllPorts: %w", err)
-	}
+			c.tuilog.Error(err2.Error())
 
-	portInfo, err := c.selectPort(allPorts, packageName)
-	if err != nil {
-		return fmt.Errorf("select port: %w", err)
+			return fmt.Errorf("projectService.GetAllPorts: %w", err2)
+		}
+
+		portInfo, err2 = c.selectPort(c.withExternalInterfaces(cmd.Context(), allPorts), packageName, cmd.Flags().Changed("assert"))
+		if err2 != nil {
+			return fmt.Errorf("select port: %w", err2)
+		}
 	}
 
-	var isGlobal bool
+	isGlobal := *c.flagGlobal
 
-	err = huh.NewForm(
-		huh.NewGroup(
-			huh.NewSelect[bool]().
-				Title("Select package scope").
-				Options(
-					huh.NewOption(
-						fmt.Sprintf("internal (%q)", filepath.Join("internal", "pkg", "*")),
-						false),
-					huh.NewOption(
-						fmt.Sprintf("global (%q)", filepath.Join("pkg", "*")),
-						true),
-				).
-				Value(&isGlobal),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
+	if !cmd.Flags().Changed("global") && interactive {
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[bool]().
+					Title("Select package scope").
+					Options(
+						huh.NewOption(
+							fmt.Sprintf("internal (%q)", filepath.Join(c.cfg.GetLayout().Package, "*")),
+							false),
+						huh.NewOption(
+							fmt.Sprintf("global (%q)", filepath.Join(c.cfg.GetLayout().GlobalPackage, "*")),
+							true),
+					).
+					Value(&isGlobal),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
 
-		c.tuilog.Error("Select a port: ", err.Error())
+			c.tuilog.Error("Select a port: ", err.Error())
 
-		return fmt.Errorf("select is global: %w", err)
+			return fmt.Errorf("select is global: %w", err)
+		}
 	}
 
 	packageFile, err := c.projectService.CreatePackage(
@@ -135,7 +174,7 @@ func (c *PackageCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		model.CreatePackageParams{
 			StructName:      packageName,
 			PackageName:     pkgName,
-			PortParam:       portInfo.portName,
+			PortParams:      portInfo.portNames,
 			AssertInterface: portInfo.assertInterface,
 			IsGlobal:        isGlobal,
 		},
@@ -159,7 +198,7 @@ func (c *PackageCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("projectService.CreatePackage: %w", err)
 	}
 
-	c.tuilog.Success("Package created\n" + packageFile)
+	c.tuilog.Success("Package created\n" + c.projectService.DisplayPath(packageFile))
 
 	return nil
 }
@@ -187,53 +226,80 @@ func (c *PackageCreateCommand) selectPkgName(instanceName string) (string, error
 	return pkgName, nil
 }
 
+// withExternalInterfaces appends the interfaces of the standard library and
+// the module dependencies to the ports. They are left out with a warning if
+// they can not be listed, since the ports can still be selected.
+func (c *PackageCreateCommand) withExternalInterfaces(ctx context.Context, allPorts []model.Port) []model.Port {
+	externalInterfaces, err := c.projectService.GetExternalInterfaces(ctx)
+	if err != nil {
+		c.tuilog.Warning("External interfaces can not be listed\n" + err.Error())
+		return allPorts
+	}
+
+	return slices.Concat(allPorts, externalInterfaces)
+}
+
 type portInfo struct {
-	portName        string
+	portNames       []string
 	assertInterface bool
 }
 
-func (c *PackageCreateCommand) selectPort(allPorts []string, instanceName string) (*portInfo, error) {
+func (c *PackageCreateCommand) selectPort(allPorts []model.Port, instanceName string, assertGiven bool) (*portInfo, error) {
 	if len(allPorts) == 0 {
 		return &portInfo{}, nil
 	}
 
-	selectPortList := []huh.Option[string]{
-		huh.NewOption[string]("Do not implement!", ""),
-	}
+	portNames := make(map[string]string, len(allPorts))
+
+	// ports are selected with their import paths, since a domain port may
+	// have the same name with a port in another directory
+	selectPortList := lo.Map(allPorts, func(p model.Port, _ int) huh.Option[string] {
+		portParam := p.ImportPath + "." + p.Name
+		portNames[portParam] = p.Package + "." + p.Name
+
+		label := p.Name + p.TypeParams
+		if p.Domain != "" {
+			label += " (" + p.Domain + ")"
+		}
+
+		// external interfaces are not in a file of the project
+		if p.Path == "" {
+			label = portParam + p.TypeParams
+		}
 
-	selectPortList = append(selectPortList, lo.Map(allPorts, func(d string, _ int) huh.Option[string] {
-		return huh.NewOption(d, d)
-	})...)
+		return huh.NewOption(label, portParam)
+	})
 
-	var portName string
+	var selectedPorts []string
 
 	err := huh.NewForm(
 		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Select a port.").
+			huh.NewMultiSelect[string]().
+				Title("Select the ports to implement.").
+				Description("Select none to implement nothing, press / to search").
+				Filterable(true).
+				Height(15).
 				Options(
 					selectPortList...,
 				).
-				Value(&portName),
+				Value(&selectedPorts),
 		).WithShowHelp(true),
 	).Run()
 	if err != nil {
-		return nil, fmt.Errorf("select a port: %w", err)
+		return nil, fmt.Errorf("select ports: %w", err)
 	}
 
-	assertInterface := false
+	assertInterface := *c.flagAssert
 
-	if portName != "" {
+	if len(selectedPorts) > 0 && !assertGiven {
 		err = huh.NewForm(
 			huh.NewGroup(
 				huh.NewConfirm().
-					Title("Do you want to assert port?").
+					Title("Do you want to assert ports?").
 					Description(
-						fmt.Sprintf(
-							"var _ port.%s = (*%s)(nil)",
-							portName,
-							instanceName,
-						),
+						strings.Join(lo.Map(selectedPorts, func(portParam string, _ int) string {
+							return fmt.Sprintf("var _ %s = (*%s)(nil)", portNames[portParam], instanceName)
+						}), "\n"),
 					).
 					Affirmative("Yes").
 					Negative("No").
@@ -246,7 +312,7 @@ func (c *PackageCreateCommand) selectPort(allPorts []string, instanceName string
 	}
 
 	return &portInfo{
-		portName:        portName,
+		portNames:       selectedPorts,
 		assertInterface: assertInterface,
 	}, nil
 }
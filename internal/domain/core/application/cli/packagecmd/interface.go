@@ -9,7 +9,13 @@ import (
 type ProjectService interface {
 	ValidateInstanceName(instanceName string) error
 	ValidatePkgName(pkgName string) error
-	GetAllPorts(ctx context.Context) ([]string, error)
+	GetAllPorts(ctx context.Context, targetDomain string) ([]model.Port, error)
+	GetExternalInterfaces(ctx context.Context) ([]model.Port, error)
 	CreatePackage(ctx context.Context, params model.CreatePackageParams) (string, error)
 	GetAllPackages(ctx context.Context, showGlobal bool) ([]string, error)
+	GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error)
+	PlanRemoval(ctx context.Context, params model.RemoveComponentParams) (*model.RemovalPlan, error)
+	RemoveComponent(ctx context.Context, plan *model.RemovalPlan) error
+	MoveComponent(ctx context.Context, params model.MoveComponentParams) (*model.MoveResult, error)
+	DisplayPath(name string) string
 }
@@ -2,12 +2,15 @@ package packagecmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -21,6 +24,7 @@ type PackageLSCommand struct {
 
 	// flags
 	flagLine   *bool
+	flagOutput *string
 	flagGlobal *bool
 	flagAll    *bool
 }
@@ -56,20 +60,48 @@ func (c *PackageLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago pkg ls -l")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago pkg ls -o json|yaml")
 	c.flagGlobal = c.cmd.Flags().BoolP("global", "g", false, "hexago pkg ls -g")
 	c.flagAll = c.cmd.Flags().BoolP("all", "a", false, "hexago pkg ls -a")
 }
 
 func (c *PackageLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	if format != output.FormatText {
+		components, err2 := c.projectService.GetAllComponents(cmd.Context(), model.KindPackage, "")
+		if err2 != nil {
+
+			c.tuilog.Error(err2.Error())
+
+			return fmt.Errorf("projectService.GetAllComponents: %w", err2)
+		}
+
+		if !*c.flagAll {
+			scope := lo.Ternary(*c.flagGlobal, model.ScopeGlobal, model.ScopeInternal)
+			components = lo.Filter(components, func(p model.Component, _ int) bool {
+				return p.Scope == scope
+			})
+		}
+
+		return output.Print(os.Stdout, format, components)
+	}
+
 	allPackages := make([]string, 0)
 
 	if *c.flagAll {
-		globalPackages, err := c.projectService.GetAllPackages(cmd.Context(), true)
-		if err != nil {
+		globalPackages, err2 := c.projectService.GetAllPackages(cmd.Context(), true)
+		if err2 != nil {
 
-			c.tuilog.Error(err.Error())
+			c.tuilog.Error(err2.Error())
 
-			return fmt.Errorf("projectService.GetAllPackages: %w", err)
+			return fmt.Errorf("projectService.GetAllPackages: %w", err2)
 		}
 
 		globalPackages = lo.Map(globalPackages, func(p string, _ int) string {
@@ -78,12 +110,12 @@ func (c *PackageLSCommand) runner(cmd *cobra.Command, _ []string) error {
 
 		allPackages = append(allPackages, globalPackages...)
 
-		packages, err := c.projectService.GetAllPackages(cmd.Context(), false)
-		if err != nil {
+		packages, err2 := c.projectService.GetAllPackages(cmd.Context(), false)
+		if err2 != nil {
 
-			c.tuilog.Error(err.Error())
+			c.tuilog.Error(err2.Error())
 
-			return fmt.Errorf("projectService.GetAllPackages: %w", err)
+			return fmt.Errorf("projectService.GetAllPackages: %w", err2)
 		}
 
 		packages = lo.Map(packages, func(p string, _ int) string {
@@ -93,12 +125,12 @@ func (c *PackageLSCommand) runner(cmd *cobra.Command, _ []string) error {
 		allPackages = append(allPackages, packages...)
 
 	} else {
-		packages, err := c.projectService.GetAllPackages(cmd.Context(), *c.flagGlobal)
-		if err != nil {
+		packages, err2 := c.projectService.GetAllPackages(cmd.Context(), *c.flagGlobal)
+		if err2 != nil {
 
-			c.tuilog.Error(err.Error())
+			c.tuilog.Error(err2.Error())
 
-			return fmt.Errorf("projectService.GetAllPackages: %w", err)
+			return fmt.Errorf("projectService.GetAllPackages: %w", err2)
 		}
 
 		allPackages = append(allPackages, packages...)
@@ -0,0 +1,257 @@
+package portcmd
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*PortCreateCommand)(nil)
+
+type PortCreateCommand struct {
+	cmd            *cobra.Command
+	cfg            *config.Config
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagName    *string
+	flagFile    *string
+	flagDomain  *string
+	flagMethods *[]string
+	flagNoInput *bool
+}
+
+const newLong = `new command creates a port (interface) under the "internal/port/" directory.
+
+If a domain is given, the port is created under the "internal/domain/<domain>/port/" directory.
+The directories can be changed with the layout section of the config.
+If the port file already exists, the port is appended to the file.`
+
+func NewPortCreateCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*PortCreateCommand, error) {
+	return &PortCreateCommand{
+		cmd: &cobra.Command{
+			Use:     "new",
+			Example: "hexago port new\nhexago port new -n UserRepository -f user -m 'GetUser(ctx context.Context, id string) (*dto.User, error)' --no-input",
+			Short:   "Create a port",
+			Long:    newLong,
+		},
+		cfg:            cfg,
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *PortCreateCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *PortCreateCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *PortCreateCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago port new -n <PortName>")
+	c.flagFile = c.cmd.Flags().StringP("file", "f", "", "hexago port new -f <filename>")
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago port new -d <domainname>")
+	c.flagMethods = c.cmd.Flags().StringArrayP("method", "m", nil, "hexago port new -m 'GetUser(ctx context.Context, id string) (*dto.User, error)'")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago port new --no-input")
+}
+
+func (c *PortCreateCommand) runner(cmd *cobra.Command, args []string) error {
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
+
+	portName := *c.flagName
+	if portName == "" && len(args) > 0 {
+		portName = args[0]
+	}
+
+	if portName == "" {
+		if !interactive {
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+			return fmt.Errorf("input port name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s port name?").
+					Placeholder("PortName").
+					Validate(c.projectService.ValidateInstanceName).
+					Description("Port name must be PascalCase").
+					Value(&portName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input port name: %w", err)
+		}
+	}
+
+	fileName := *c.flagFile
+	if fileName == "" && interactive {
+		err := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s file name?").
+					Placeholder(strings.ToLower(portName)).
+					Validate(func(s string) error {
+						if s == "" {
+							return nil
+						}
+						return c.projectService.ValidateFileName(s)
+					}).
+					Description("Port is appended to the file if it exists").
+					Value(&fileName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input file name: %w", err)
+		}
+	}
+
+	domainName := *c.flagDomain
+	if !cmd.Flags().Changed("domain") && interactive {
+		var err error
+		domainName, err = c.selectDomain(cmd)
+		if err != nil {
+			return fmt.Errorf("select domain: %w", err)
+		}
+	}
+
+	methods := *c.flagMethods
+	if !cmd.Flags().Changed("method") && interactive {
+		var err error
+		methods, err = c.inputMethods()
+		if err != nil {
+			return fmt.Errorf("input methods: %w", err)
+		}
+	}
+
+	portFile, err := c.projectService.CreatePort(
+		cmd.Context(),
+		model.CreatePortParams{
+			TargetDomain: domainName,
+			PortName:     portName,
+			FileName:     fileName,
+			Methods:      methods,
+		},
+	)
+	if err != nil {
+
+		if errors.Is(err, customerrors.ErrInvalidInstanceName) {
+			c.tuilog.Error("Port name not valid\nMust be <PascalCase>")
+		} else if errors.Is(err, customerrors.ErrInvalidFileName) {
+			c.tuilog.Error("File name not valid\nMust be <snake_case>")
+		} else if errors.Is(err, customerrors.ErrDomainNotFound) {
+			c.tuilog.Error("Domain not found")
+		} else if errors.Is(err, customerrors.ErrAlreadyExist) {
+			c.tuilog.Error("Port already exists")
+		} else if err2, ok1 := lo.ErrorsAs[customerrors.ErrInvalidMethodSignature](err); ok1 {
+			c.tuilog.Error("Method signature not valid\n" + err2.Error())
+		} else if err2, ok2 := lo.ErrorsAs[customerrors.ErrFormatGoFile](err); ok2 {
+			c.tuilog.Error("Go file doesn't formatted\n" + err2.Message)
+		} else {
+			c.tuilog.Error(err.Error())
+		}
+
+		return fmt.Errorf("projectService.CreatePort: %w", err)
+	}
+
+	c.tuilog.Success("Port created\n" + c.projectService.DisplayPath(portFile))
+
+	return nil
+}
+
+func (c *PortCreateCommand) selectDomain(cmd *cobra.Command) (string, error) {
+	domains, err := c.projectService.GetAllDomains(cmd.Context())
+	if err != nil {
+		return "", fmt.Errorf("projectService.GetAllDomains: %w", err)
+	}
+
+	layout := c.cfg.GetLayout()
+
+	selectList := []huh.Option[string]{
+		huh.NewOption(filepath.ToSlash(layout.Port), ""),
+	}
+
+	selectList = append(selectList, lo.Map(domains, func(d string, _ int) huh.Option[string] {
+		return huh.NewOption(path.Join(filepath.ToSlash(layout.Domain), d, filepath.ToSlash(layout.DomainPort)), d)
+	})...)
+
+	var domainName string
+
+	err = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Where should the port be created?").
+				Options(
+					selectList...,
+				).
+				Value(&domainName),
+		).WithShowHelp(true),
+	).Run()
+	if err != nil {
+		return "", fmt.Errorf("select a domain: %w", err)
+	}
+
+	return domainName, nil
+}
+
+// inputMethods asks method signatures one by one until an empty one is given.
+func (c *PortCreateCommand) inputMethods() ([]string, error) {
+	methods := make([]string, 0)
+
+	for {
+		var method string
+
+		err := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title(fmt.Sprintf("Method %d (leave empty to finish)", len(methods)+1)).
+					Placeholder("GetUser(ctx context.Context, id string) (*dto.User, error)").
+					Validate(func(s string) error {
+						if s == "" {
+							return nil
+						}
+						return c.projectService.ValidateMethodSignature(s)
+					}).
+					Value(&method),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return nil, fmt.Errorf("input method: %w", err)
+		}
+
+		if method == "" {
+			return methods, nil
+		}
+
+		if slices.Contains(methods, method) {
+			continue
+		}
+
+		methods = append(methods, method)
+	}
+}
@@ -1,7 +1,20 @@
 package portcmd
 
-import "context"
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
 
 type ProjectService interface {
-	GetAllPorts(ctx context.Context) ([]string, error)
+	GetAllDomains(ctx context.Context) ([]string, error)
+	CreatePort(ctx context.Context, params model.CreatePortParams) (string, error)
+	SyncPort(ctx context.Context, params model.SyncPortParams) ([]model.PortSyncResult, error)
+	GenerateMocks(ctx context.Context, params model.GenerateMocksParams) ([]model.GeneratedMock, error)
+	ValidateInstanceName(instanceName string) error
+	ValidateFileName(fileName string) error
+	ValidateMethodSignature(signature string) error
+	GetAllPorts(ctx context.Context, targetDomain string) ([]model.Port, error)
+	GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error)
+	DisplayPath(name string) string
 }
@@ -2,12 +2,15 @@ package portcmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -20,14 +23,17 @@ type PortLSCommand struct {
 	projectService ProjectService
 
 	// flags
-	flagLine *bool
+	flagLine    *bool
+	flagMethods *bool
+	flagOutput  *string
+	flagDomain  *string
 }
 
 func NewPortLSCommand(prokectService ProjectService, tl *tuilog.TUILog) (*PortLSCommand, error) {
 	return &PortLSCommand{
 		cmd: &cobra.Command{
 			Use:     "ls",
-			Example: "hexago port ls -d <domainname>\nhexago port ls (select domain interactively)",
+			Example: "hexago port ls\nhexago port ls -d <domainname>\nhexago port ls -m\nhexago port ls -o json",
 			Short:   "List ports",
 			Long:    `List ports`,
 		},
@@ -54,10 +60,21 @@ func (c *PortLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago port ls -l")
+	c.flagMethods = c.cmd.Flags().BoolP("methods", "m", false, "hexago port ls -m")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago port ls -o json|yaml")
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago port ls -d <domainname>")
 }
 
 func (c *PortLSCommand) runner(cmd *cobra.Command, _ []string) error {
-	allPorts, err := c.projectService.GetAllPorts(cmd.Context())
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	allPorts, err := c.projectService.GetAllPorts(cmd.Context(), *c.flagDomain)
 	if err != nil {
 
 		c.tuilog.Error(err.Error())
@@ -65,9 +82,41 @@ func (c *PortLSCommand) runner(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("projectService.GetAllPorts: %w", err)
 	}
 
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, allPorts)
+	}
+
+	if *c.flagMethods {
+		c.printPortDetails(allPorts)
+		return nil
+	}
+
 	separator := lo.Ternary(*c.flagLine, "\n", " ")
 
-	fmt.Println(strings.Join(allPorts, separator))
+	fmt.Println(strings.Join(lo.Map(allPorts, func(p model.Port, _ int) string {
+		if p.Domain != "" {
+			return p.Domain + "/" + p.Name
+		}
+		return p.Name
+	}), separator))
 
 	return nil
 }
+
+func (c *PortLSCommand) printPortDetails(ports []model.Port) {
+	for i, p := range ports {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Printf("%s%s (%s)\n", p.Name, p.TypeParams, c.projectService.DisplayPath(p.Path))
+
+		for _, embed := range p.Embeds {
+			fmt.Printf("  %s\n", embed)
+		}
+
+		for _, m := range p.Methods {
+			fmt.Printf("  %s%s\n", m.Name, m.Signature)
+		}
+	}
+}
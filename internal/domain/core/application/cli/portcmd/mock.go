@@ -0,0 +1,136 @@
+package portcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*PortMockCommand)(nil)
+
+type PortMockCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagAll    *bool
+	flagStyle  *string
+	flagDomain *string
+	flagOutput *string
+}
+
+const mockLong = `mock command generates mock implementations of ports.
+
+Mocks of the ports in the port directory of the layout (default: internal/port) are written into the mocks directory in the config (default: <port dir>/mocks).
+Mocks of domain ports are written into the directory of the same name next to the port directory of the domain.
+
+Styles:
+  testify  mocks which embed mock.Mock of github.com/stretchr/testify
+  func     fakes which call a function field for each method`
+
+func NewPortMockCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*PortMockCommand, error) {
+	return &PortMockCommand{
+		cmd: &cobra.Command{
+			Use:     "mock [PortName]",
+			Example: "hexago port mock UserRepository\nhexago port mock UserRepository --style func\nhexago port mock --all",
+			Short:   "Generate mocks of ports",
+			Long:    mockLong,
+			Args:    cobra.MaximumNArgs(1),
+		},
+		projectService: projectService,
+		cfg:            cfg,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *PortMockCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *PortMockCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *PortMockCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagAll = c.cmd.Flags().Bool("all", false, "hexago port mock --all")
+	c.flagStyle = c.cmd.Flags().String("style", "", "hexago port mock <PortName> --style testify|func")
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago port mock <PortName> -d <domainname>")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago port mock <PortName> -o json|yaml")
+}
+
+func (c *PortMockCommand) runner(cmd *cobra.Command, args []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	err = c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	var portName string
+	if len(args) > 0 {
+		portName = args[0]
+	}
+
+	if (portName == "") == !*c.flagAll {
+		err = errors.New("either a port name or --all must be given")
+		c.tuilog.Error(err.Error())
+		return err
+	}
+
+	mocks, err := c.projectService.GenerateMocks(cmd.Context(), model.GenerateMocksParams{
+		TargetDomain: *c.flagDomain,
+		PortName:     portName,
+		All:          *c.flagAll,
+		Style:        *c.flagStyle,
+	})
+	if err != nil {
+
+		if err2, ok := lo.ErrorsAs[customerrors.ErrMissingRequirement](err); ok {
+			c.tuilog.Error(fmt.Sprintf("testify mocks need %s in go.mod, run:\ngo get %s\nor use --style func", err2.Module, err2.Module))
+		} else {
+			c.tuilog.Error(err.Error())
+		}
+
+		return fmt.Errorf("projectService.GenerateMocks: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, mocks)
+	}
+
+	if len(mocks) == 0 {
+		c.tuilog.Warning("No port found")
+		return nil
+	}
+
+	for _, mock := range mocks {
+		c.tuilog.Success(fmt.Sprintf("Mock generated (%s)\n%s", mock.Style, c.projectService.DisplayPath(mock.File)), mock.Port)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package portcmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*PortSyncCommand)(nil)
+
+type PortSyncCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagDomain *string
+	flagOutput *string
+}
+
+const syncLong = `sync command finds the implementers of a port and appends stubs of the missing methods.
+
+Implementers are the structs which are asserted as the port, or returned as the port from a function.
+If no port is given, all ports are synced.
+Methods whose signatures differ from the port are reported, but not modified.`
+
+func NewPortSyncCommand(projectService ProjectService, tl *tuilog.TUILog) (*PortSyncCommand, error) {
+	return &PortSyncCommand{
+		cmd: &cobra.Command{
+			Use:     "sync [PortName]",
+			Example: "hexago port sync\nhexago port sync UserRepository\nhexago port sync UserRepository -d <domainname>",
+			Short:   "Add missing methods to implementations of ports",
+			Long:    syncLong,
+			Args:    cobra.MaximumNArgs(1),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *PortSyncCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *PortSyncCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *PortSyncCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago port sync -d <domainname>")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago port sync -o json|yaml")
+}
+
+func (c *PortSyncCommand) runner(cmd *cobra.Command, args []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	var portParam string
+	if len(args) > 0 {
+		portParam = args[0]
+	}
+
+	results, err := c.projectService.SyncPort(cmd.Context(), model.SyncPortParams{
+		TargetDomain: *c.flagDomain,
+		PortParam:    portParam,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.SyncPort: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, results)
+	}
+
+	if len(results) == 0 {
+		c.tuilog.Warning("No implementation found")
+		return nil
+	}
+
+	for _, result := range results {
+		title := fmt.Sprintf("%s (%s)", result.Implementer, result.Port)
+
+		if len(result.DriftedMethods) > 0 {
+			lines := make([]string, 0, len(result.DriftedMethods))
+			for _, drift := range result.DriftedMethods {
+				lines = append(lines, fmt.Sprintf("%s\n  have %s\n  want %s", drift.Name, drift.Have, drift.Want))
+			}
+			c.tuilog.Warning("Signature drifted\n"+strings.Join(lines, "\n"), title)
+		}
+
+		if len(result.AddedMethods) > 0 {
+			c.tuilog.Success(fmt.Sprintf("Added %s\n%s", strings.Join(result.AddedMethods, ", "), c.projectService.DisplayPath(result.File)), title)
+		} else if len(result.DriftedMethods) == 0 {
+			c.tuilog.Info("In sync\n"+c.projectService.DisplayPath(result.File), title)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package rootcmd
+
+import "github.com/ksckaan1/hexago/internal/pkg/filesystem"
+
+type FileSystem interface {
+	EnableDryRun()
+	Changes() ([]filesystem.Change, error)
+}
@@ -1,34 +1,91 @@
 package rootcmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/pkg/version"
 	"github.com/ksckaan1/hexago/internal/port"
 )
 
 var _ port.Commander = (*RootCommand)(nil)
 
 type RootCommand struct {
-	cmd *cobra.Command
+	cmd    *cobra.Command
+	tuilog *tuilog.TUILog
+	fs     FileSystem
+
+	// flags
+	flagDryRun *bool
 }
 
-func NewRootCommand() (*RootCommand, error) {
+func NewRootCommand(fsys FileSystem, tl *tuilog.TUILog) (*RootCommand, error) {
 	return &RootCommand{
 		cmd: &cobra.Command{
 			Use:           "hexago",
 			Short:         "short description",
 			Long:          header + "\nhexago is a cli tool for initializing and managing hexagonal Go projects.",
-			Version:       "v0.5.1",
+			Version:       version.Version,
 			SilenceUsage:  true,
 			SilenceErrors: true,
 		},
+		tuilog: tl,
+		fs:     fsys,
 	}, nil
 }
 
 func (c *RootCommand) Command() *cobra.Command {
+	c.init()
 	return c.cmd
 }
 
 func (c *RootCommand) AddSubCommand(cmd port.Commander) {
 	c.cmd.AddCommand(cmd.Command())
 }
+
+func (c *RootCommand) init() {
+	c.cmd.PersistentPreRun = func(_ *cobra.Command, _ []string) {
+		if *c.flagDryRun {
+			c.fs.EnableDryRun()
+		}
+	}
+	c.cmd.PersistentPostRunE = func(cmd *cobra.Command, _ []string) error {
+		if !*c.flagDryRun {
+			return nil
+		}
+		err := c.printChanges(cmd)
+		if err != nil {
+			c.tuilog.Error(err.Error(), "Dry run")
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagDryRun = c.cmd.PersistentFlags().Bool("dry-run", false, "print the changes as a unified diff instead of writing them")
+}
+
+func (c *RootCommand) printChanges(cmd *cobra.Command) error {
+	changes, err := c.fs.Changes()
+	if err != nil {
+		return fmt.Errorf("fs: changes: %w", err)
+	}
+
+	if len(changes) == 0 {
+		c.tuilog.Info("No file would be changed", "Dry run")
+		return nil
+	}
+
+	for _, change := range changes {
+		diff, err2 := change.Diff()
+		if err2 != nil {
+			return fmt.Errorf("change: diff: %w", err2)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), diff)
+	}
+
+	c.tuilog.Info(fmt.Sprintf("%d file(s) would be changed. Nothing is written to the disk.", len(changes)), "Dry run")
+
+	return nil
+}
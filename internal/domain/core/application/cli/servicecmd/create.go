@@ -1,8 +1,10 @@
 package servicecmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/huh"
@@ -12,6 +14,7 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -23,13 +26,22 @@ type ServiceCreateCommand struct {
 	tuilog         *tuilog.TUILog
 	projectService ProjectService
 	cfg            *config.Config
+
+	// flags
+	flagName    *string
+	flagPkg     *string
+	flagDomain  *string
+	flagPorts   *[]string
+	flagAssert  *bool
+	flagDeps    *[]string
+	flagNoInput *bool
 }
 
 func NewServiceCreateCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*ServiceCreateCommand, error) {
 	return &ServiceCreateCommand{
 		cmd: &cobra.Command{
 			Use:     "new",
-			Example: "hexago service new",
+			Example: "hexago service new\nhexago service new -n <ServiceName> -d <domainname> --port <PortName> --assert --dep <PortName> --no-input",
 			Short:   "Create a service",
 			Long:    `Create a service`,
 		},
@@ -56,6 +68,13 @@ func (c *ServiceCreateCommand) init() {
 		}
 		return nil
 	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "", "hexago service new -n <ServiceName>")
+	c.flagPkg = c.cmd.Flags().StringP("pkg", "p", "", "hexago service new -p <foldername>")
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago service new -d <domainname>")
+	c.flagPorts = c.cmd.Flags().StringArray("port", nil, "hexago service new --port <PortName> --port <PortName>")
+	c.flagAssert = c.cmd.Flags().Bool("assert", false, "hexago service new --port <PortName> --assert")
+	c.flagDeps = c.cmd.Flags().StringArray("dep", nil, "hexago service new --dep <PortName> --dep <PortName>")
+	c.flagNoInput = c.cmd.Flags().Bool("no-input", false, "hexago service new --no-input")
 }
 
 func (c *ServiceCreateCommand) runner(cmd *cobra.Command, args []string) error {
@@ -80,35 +99,55 @@ func (c *ServiceCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("No domains found.\nA domain needs to be created first")
 	}
 
-	var serviceName string
+	interactive := !*c.flagNoInput && terminal.IsInteractive()
 
-	if len(args) > 0 {
+	serviceName := *c.flagName
+	if serviceName == "" && len(args) > 0 {
 		serviceName = args[0]
 	}
 
-	err = huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title("What’s service name?").
-				Placeholder("ServiceName").
-				Validate(c.projectService.ValidateInstanceName).
-				Description("Service name must be PascalCase").
-				Value(&serviceName),
-		).WithShowHelp(true),
-	).Run()
-	if err != nil {
-		return fmt.Errorf("input service name: %w", err)
+	if serviceName == "" {
+		if !interactive {
+			c.tuilog.Error(customerrors.ErrMissingInput{Flag: "name"}.Error())
+			return fmt.Errorf("input service name: %w", customerrors.ErrMissingInput{Flag: "name"})
+		}
+
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("What’s service name?").
+					Placeholder("ServiceName").
+					Validate(c.projectService.ValidateInstanceName).
+					Description("Service name must be PascalCase").
+					Value(&serviceName),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return fmt.Errorf("input service name: %w", err)
+		}
 	}
 
-	pkgName, err := c.selectPkgName(serviceName)
-	if err != nil {
-		return fmt.Errorf("select pkg name: %w", err)
+	pkgName := *c.flagPkg
+	if pkgName == "" && interactive {
+		pkgName, err = c.selectPkgName(serviceName)
+		if err != nil {
+			return fmt.Errorf("select pkg name: %w", err)
+		}
 	}
 
-	var domainName string
-	if len(domains) == 1 {
+	domainName := *c.flagDomain
+	switch {
+	case domainName != "":
+		if !slices.Contains(domains, domainName) {
+			c.tuilog.Error("Domain not found: " + domainName)
+			return fmt.Errorf("domain not found: %w (%s)", customerrors.ErrDomainNotFound, domainName)
+		}
+	case len(domains) == 1:
 		domainName = domains[0]
-	} else {
+	case !interactive:
+		c.tuilog.Error(customerrors.ErrMissingInput{Flag: "domain"}.Error())
+		return fmt.Errorf("select a domain: %w", customerrors.ErrMissingInput{Flag: "domain"})
+	default:
 		selectList := lo.Map(domains, func(d string, _ int) huh.Option[string] {
 			return huh.NewOption(d, d)
 		})
@@ -131,17 +170,35 @@ func (c *ServiceCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	allPorts, err := c.projectService.GetAllPorts(cmd.Context())
-	if err != nil {
+	portInfo := &portInfo{
+		portNames:       *c.flagPorts,
+		assertInterface: *c.flagAssert,
+	}
 
-		c.tuilog.Error(err.Error())
+	dependencies := *c.flagDeps
 
-		return fmt.Errorf("projectService.GetAllPorts: %w", err)
-	}
+	if interactive && (!cmd.Flags().Changed("port") || !cmd.Flags().Changed("dep")) {
+		allPorts, err2 := c.projectService.GetAllPorts(cmd.Context(), domainName)
+		if err2 != nil {
 
-	portInfo, err := c.selectPort(allPorts, serviceName)
-	if err != nil {
-		return fmt.Errorf("select port: %w", err)
+			c.tuilog.Error(err2.Error())
+
+			return fmt.Errorf("projectService.GetAllPorts: %w", err2)
+		}
+
+		if !cmd.Flags().Changed("port") {
+			portInfo, err2 = c.selectPort(c.withExternalInterfaces(cmd.Context(), allPorts), serviceName, cmd.Flags().Changed("assert"))
+			if err2 != nil {
+				return fmt.Errorf("select port: %w", err2)
+			}
+		}
+
+		if !cmd.Flags().Changed("dep") {
+			dependencies, err2 = c.selectDependencies(allPorts)
+			if err2 != nil {
+				return fmt.Errorf("select dependencies: %w", err2)
+			}
+		}
 	}
 
 	serviceFile, err := c.projectService.CreateService(
@@ -150,8 +207,9 @@ func (c *ServiceCreateCommand) runner(cmd *cobra.Command, args []string) error {
 			TargetDomain:    domainName,
 			StructName:      serviceName,
 			PackageName:     pkgName,
-			PortParam:       portInfo.portName,
+			PortParams:      portInfo.portNames,
 			AssertInterface: portInfo.assertInterface,
+			Dependencies:    dependencies,
 		},
 	)
 	if err != nil {
@@ -175,7 +233,7 @@ func (c *ServiceCreateCommand) runner(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("projectService.CreateService: %w", err)
 	}
 
-	c.tuilog.Success("Service created\n" + serviceFile)
+	c.tuilog.Success("Service created\n" + c.projectService.DisplayPath(serviceFile))
 
 	return nil
 }
@@ -203,53 +261,80 @@ func (c *ServiceCreateCommand) selectPkgName(instanceName string) (string, error
 	return pkgName, nil
 }
 
+// withExternalInterfaces appends the interfaces of the standard library and
+// the module dependencies to the ports. They are left out with a warning if
+// they can not be listed, since the ports can still be selected.
+func (c *ServiceCreateCommand) withExternalInterfaces(ctx context.Context, allPorts []model.Port) []model.Port {
+	externalInterfaces, err := c.projectService.GetExternalInterfaces(ctx)
+	if err != nil {
+		c.tuilog.Warning("External interfaces can not be listed\n" + err.Error())
+		return allPorts
+	}
+
+	return slices.Concat(allPorts, externalInterfaces)
+}
+
 type portInfo struct {
-	portName        string
+	portNames       []string
 	assertInterface bool
 }
 
-func (c *ServiceCreateCommand) selectPort(allPorts []string, instanceName string) (*portInfo, error) {
+func (c *ServiceCreateCommand) selectPort(allPorts []model.Port, instanceName string, assertGiven bool) (*portInfo, error) {
 	if len(allPorts) == 0 {
 		return &portInfo{}, nil
 	}
 
-	selectPortList := []huh.Option[string]{
-		huh.NewOption[string]("Do not implement!", ""),
-	}
+	portNames := make(map[string]string, len(allPorts))
+
+	// ports are selected with their import paths, since a domain port may
+	// have the same name with a port in another directory
+	selectPortList := lo.Map(allPorts, func(p model.Port, _ int) huh.Option[string] {
+		portParam := p.ImportPath + "." + p.Name
+		portNames[portParam] = p.Package + "." + p.Name
 
-	selectPortList = append(selectPortList, lo.Map(allPorts, func(d string, _ int) huh.Option[string] {
-		return huh.NewOption(d, d)
-	})...)
+		label := p.Name + p.TypeParams
+		if p.Domain != "" {
+			label += " (" + p.Domain + ")"
+		}
 
-	var portName string
+		// external interfaces are not in a file of the project
+		if p.Path == "" {
+			label = portParam + p.TypeParams
+		}
+
+		return huh.NewOption(label, portParam)
+	})
+
+	var selectedPorts []string
 
 	err := huh.NewForm(
 		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Select a port.").
+			huh.NewMultiSelect[string]().
+				Title("Select the ports to implement.").
+				Description("Select none to implement nothing, press / to search").
+				Filterable(true).
+				Height(15).
 				Options(
 					selectPortList...,
 				).
-				Value(&portName),
+				Value(&selectedPorts),
 		).WithShowHelp(true),
 	).Run()
 	if err != nil {
-		return nil, fmt.Errorf("select a port: %w", err)
+		return nil, fmt.Errorf("select ports: %w", err)
 	}
 
-	assertInterface := false
+	assertInterface := *c.flagAssert
 
-	if portName != "" {
+	if len(selectedPorts) > 0 && !assertGiven {
 		err = huh.NewForm(
 			huh.NewGroup(
 				huh.NewConfirm().
-					Title("Do you want to assert port?").
+					Title("Do you want to assert ports?").
 					Description(
-						fmt.Sprintf(
-							"var _ port.%s = (*%s)(nil)",
-							portName,
-							instanceName,
-						),
+						strings.Join(lo.Map(selectedPorts, func(portParam string, _ int) string {
+							return fmt.Sprintf("var _ %s = (*%s)(nil)", portNames[portParam], instanceName)
+						}), "\n"),
 					).
 					Affirmative("Yes").
 					Negative("No").
@@ -262,7 +347,40 @@ func (c *ServiceCreateCommand) selectPort(allPorts []string, instanceName string
 	}
 
 	return &portInfo{
-		portName:        portName,
+		portNames:       selectedPorts,
 		assertInterface: assertInterface,
 	}, nil
 }
+
+// selectDependencies selects the ports which the service depends on. They become
+// the fields of the struct and the parameters of New.
+func (c *ServiceCreateCommand) selectDependencies(allPorts []model.Port) ([]string, error) {
+	if len(allPorts) == 0 {
+		return nil, nil
+	}
+
+	var dependencies []string
+
+	err := huh.NewForm(
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Select the ports which the service depends on.").
+				Description("They are injected into New").
+				Options(
+					lo.Map(allPorts, func(p model.Port, _ int) huh.Option[string] {
+						label := p.Name + p.TypeParams
+						if p.Domain != "" {
+							label += " (" + p.Domain + ")"
+						}
+						return huh.NewOption(label, p.ImportPath+"."+p.Name)
+					})...,
+				).
+				Value(&dependencies),
+		).WithShowHelp(true),
+	).Run()
+	if err != nil {
+		return nil, fmt.Errorf("select dependencies: %w", err)
+	}
+
+	return dependencies, nil
+}
@@ -3,6 +3,7 @@ package servicecmd
 import (
 	"errors"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -24,6 +28,7 @@ type ServiceLSCommand struct {
 
 	// flags
 	flagLine   *bool
+	flagOutput *string
 	flagDomain *string
 }
 
@@ -58,10 +63,17 @@ func (c *ServiceLSCommand) init() {
 		return nil
 	}
 	c.flagLine = c.cmd.Flags().BoolP("line", "l", false, "hexago service ls -l")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago service ls -o json|yaml")
 	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago service ls -d <domainname>")
 }
 
 func (c *ServiceLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
 	domains, err := c.projectService.GetAllDomains(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("projectService.GetAllDomains: %w", err)
@@ -75,6 +87,8 @@ func (c *ServiceLSCommand) runner(cmd *cobra.Command, _ []string) error {
 	if *c.flagDomain == "" {
 		if len(domains) == 1 {
 			*c.flagDomain = domains[0]
+		} else if format != output.FormatText || !terminal.IsInteractive() {
+			*c.flagDomain = "*"
 		} else {
 
 			selectList := []huh.Option[string]{
@@ -106,6 +120,16 @@ func (c *ServiceLSCommand) runner(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("domain not found: %s", *c.flagDomain)
 	}
 
+	if format != output.FormatText {
+		components, err2 := c.projectService.GetAllComponents(cmd.Context(), model.KindService, *c.flagDomain)
+		if err2 != nil {
+			c.tuilog.Error(err2.Error())
+			return fmt.Errorf("projectService.GetAllComponents: %w", err2)
+		}
+
+		return output.Print(os.Stdout, format, components)
+	}
+
 	allServices := make([]string, 0)
 
 	for i := range domains {
@@ -0,0 +1,102 @@
+package servicecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*ServiceMoveCommand)(nil)
+
+type ServiceMoveCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagDomain *string
+	flagTo     *string
+	flagStruct *string
+}
+
+const moveLong = `mv command renames the service directory and package clause, renames the struct type with --struct and moves the service to another domain with --to.
+
+Import paths and qualified references are rewritten in every package of the module.`
+
+func NewServiceMoveCommand(projectService ProjectService, tl *tuilog.TUILog) (*ServiceMoveCommand, error) {
+	return &ServiceMoveCommand{
+		cmd: &cobra.Command{
+			Use:     "mv <servicename> [newservicename]",
+			Example: "hexago service mv <servicename> <newservicename>\nhexago service mv <servicename> --to <domainname>\nhexago service mv <servicename> <newservicename> --struct <NewStructName>",
+			Short:   "Rename or move a service",
+			Long:    moveLong,
+			Args:    cobra.RangeArgs(1, 2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *ServiceMoveCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *ServiceMoveCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *ServiceMoveCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagDomain = c.cmd.Flags().StringP("domain", "d", "", "hexago service mv <servicename> <newservicename> -d <domainname>")
+	c.flagTo = c.cmd.Flags().String("to", "", "hexago service mv <servicename> --to <domainname>")
+	c.flagStruct = c.cmd.Flags().String("struct", "", "hexago service mv <servicename> <newservicename> --struct <NewStructName>")
+}
+
+func (c *ServiceMoveCommand) runner(cmd *cobra.Command, args []string) error {
+	var newName string
+	if len(args) > 1 {
+		newName = args[1]
+	}
+
+	result, err := c.projectService.MoveComponent(cmd.Context(), model.MoveComponentParams{
+		Kind:          model.KindService,
+		Name:          args[0],
+		NewName:       newName,
+		TargetDomain:  *c.flagDomain,
+		NewDomain:     *c.flagTo,
+		NewStructName: *c.flagStruct,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.MoveComponent: %w", err)
+	}
+
+	msg := fmt.Sprintf("%s -> %s", c.projectService.DisplayPath(result.From.Path), c.projectService.DisplayPath(result.To.Path))
+	if len(result.UpdatedFiles) > 0 {
+		msg += "\n\nUpdated files:\n" + strings.Join(lo.Map(result.UpdatedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n")
+	}
+
+	c.tuilog.Success(msg, "Service Moved")
+
+	if len(result.SkippedFiles) > 0 {
+		c.tuilog.Warning("Files excluded by build constraints are not updated:\n" + strings.Join(lo.Map(result.SkippedFiles, func(name string, _ int) string { return c.projectService.DisplayPath(name) }), "\n"))
+	}
+
+	return nil
+}
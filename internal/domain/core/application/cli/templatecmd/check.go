@@ -0,0 +1,123 @@
+package templatecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*TemplateCheckCommand)(nil)
+
+type TemplateCheckCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagOutput *string
+}
+
+const checkLong = `check command renders every built-in and custom template with sample data.
+
+A template fails if it can not be parsed or executed, if it does not produce "<pkg>.go" or if a produced Go file is not valid.
+Exits with a non-zero code if any template fails.`
+
+func NewTemplateCheckCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*TemplateCheckCommand, error) {
+	return &TemplateCheckCommand{
+		cmd: &cobra.Command{
+			Use:     "check",
+			Example: "hexago template check\nhexago template check -o json",
+			Short:   "Check that all templates render valid Go",
+			Long:    checkLong,
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		cfg:            cfg,
+	}, nil
+}
+
+func (c *TemplateCheckCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *TemplateCheckCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *TemplateCheckCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago template check -o json|yaml")
+}
+
+func (c *TemplateCheckCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	err = c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	checks, err := c.projectService.CheckTemplates(cmd.Context())
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.CheckTemplates: %w", err)
+	}
+
+	failed := 0
+	for _, check := range checks {
+		if check.Error != "" {
+			failed++
+		}
+	}
+
+	if format != output.FormatText {
+		err = output.Print(os.Stdout, format, checks)
+		if err != nil {
+			return fmt.Errorf("output.Print: %w", err)
+		}
+	} else {
+		for _, check := range checks {
+			status := "ok"
+			if check.Error != "" {
+				status = "FAIL"
+			}
+
+			fmt.Printf("%-4s %s/%s\n", status, check.Template.Kind, check.Template.Mode)
+
+			if check.Error != "" {
+				fmt.Printf("     %s\n", check.Error)
+			}
+		}
+	}
+
+	if failed > 0 {
+		if format == output.FormatText {
+			c.tuilog.Error(fmt.Sprintf("%d template(s) failed", failed), "Template Check")
+		}
+		return fmt.Errorf("%d template(s) failed", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package templatecmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*TemplateEjectCommand)(nil)
+
+type TemplateEjectCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagName *string
+}
+
+const ejectLong = `eject command copies a built-in template into ".hexago/templates/<name>/<kind>/" to be edited.
+
+The ejected template is not used until it is selected in the "templates" section of ".hexago/config.yaml".`
+
+func NewTemplateEjectCommand(projectService ProjectService, tl *tuilog.TUILog) (*TemplateEjectCommand, error) {
+	return &TemplateEjectCommand{
+		cmd: &cobra.Command{
+			Use:     "eject <kind> <mode>",
+			Example: "hexago template eject service std\nhexago template eject application do -n mydo\nhexago template eject cmd http -n myhttp",
+			Short:   "Copy a built-in template to edit",
+			Long:    ejectLong,
+			Args:    cobra.ExactArgs(2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *TemplateEjectCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *TemplateEjectCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *TemplateEjectCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "custom", "hexago template eject <kind> <mode> -n <templatename>")
+}
+
+func (c *TemplateEjectCommand) runner(cmd *cobra.Command, args []string) error {
+	templateDir, err := c.projectService.EjectTemplate(cmd.Context(), model.EjectTemplateParams{
+		Kind: args[0],
+		Mode: args[1],
+		Name: *c.flagName,
+	})
+	if err != nil {
+
+		if errors.Is(err, customerrors.ErrInvalidTemplateName) {
+			c.tuilog.Error("Template name must be kebab-case and can not be std or do", "Invalid template name")
+		} else {
+			c.tuilog.Error(err.Error())
+		}
+
+		return fmt.Errorf("projectService.EjectTemplate: %w", err)
+	}
+
+	configKey := args[0]
+	if configKey == "infra" {
+		configKey = "infrastructure"
+	}
+
+	c.tuilog.Success(fmt.Sprintf(
+		"%s\n\nSelect it in .hexago/config.yaml to use it:\n\ntemplates:\n  %s: %s",
+		c.projectService.DisplayPath(templateDir), configKey, *c.flagName,
+	), "Template Ejected")
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package templatecmd
+
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+type ProjectService interface {
+	GetAllTemplates(ctx context.Context, kind string) ([]model.Template, error)
+	EjectTemplate(ctx context.Context, params model.EjectTemplateParams) (string, error)
+	RenderTemplate(ctx context.Context, params model.RenderTemplateParams) ([]model.RenderedFile, error)
+	CheckTemplates(ctx context.Context) ([]model.TemplateCheck, error)
+	DisplayPath(name string) string
+}
@@ -0,0 +1,115 @@
+package templatecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*TemplateLSCommand)(nil)
+
+type TemplateLSCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagKind   *string
+	flagOutput *string
+}
+
+const lsLong = `ls command lists the built-in and the custom templates of every kind.
+
+The template which is selected in ".hexago/config.yaml" is marked with "*".`
+
+func NewTemplateLSCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*TemplateLSCommand, error) {
+	return &TemplateLSCommand{
+		cmd: &cobra.Command{
+			Use:     "ls",
+			Example: "hexago template ls\nhexago template ls -k service\nhexago template ls -o json",
+			Short:   "List templates",
+			Long:    lsLong,
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		cfg:            cfg,
+	}, nil
+}
+
+func (c *TemplateLSCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *TemplateLSCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *TemplateLSCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagKind = c.cmd.Flags().StringP("kind", "k", "", "hexago template ls -k service|application|infra|package|cmd|<kind>")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago template ls -o json|yaml")
+}
+
+func (c *TemplateLSCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	err = c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	templates, err := c.projectService.GetAllTemplates(cmd.Context(), *c.flagKind)
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.GetAllTemplates: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, templates)
+	}
+
+	for i, t := range templates {
+		if i == 0 || templates[i-1].Kind != t.Kind {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(t.Kind)
+		}
+
+		marker := " "
+		if t.Selected {
+			marker = "*"
+		}
+
+		location := "built-in"
+		if !t.BuiltIn {
+			location = c.projectService.DisplayPath(t.Path)
+		}
+
+		fmt.Printf("%s %-12s %s\n", marker, t.Mode, location)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package templatecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*TemplateRenderCommand)(nil)
+
+type TemplateRenderCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+	cfg            *config.Config
+
+	// flags
+	flagName   *string
+	flagOutput *string
+}
+
+const renderLong = `render command renders a template with sample data and prints the files to stdout. Nothing is written.
+
+The sample component implements a "Repository" port, so the interface assertion and the method stubs are rendered too.
+If the mode is not given, the template selected in ".hexago/config.yaml" is rendered.`
+
+func NewTemplateRenderCommand(projectService ProjectService, cfg *config.Config, tl *tuilog.TUILog) (*TemplateRenderCommand, error) {
+	return &TemplateRenderCommand{
+		cmd: &cobra.Command{
+			Use:     "render <kind> [mode]",
+			Example: "hexago template render service\nhexago template render service custom -n UserService\nhexago template render infra do -o json",
+			Short:   "Render a template with sample data",
+			Long:    renderLong,
+			Args:    cobra.RangeArgs(1, 2),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+		cfg:            cfg,
+	}, nil
+}
+
+func (c *TemplateRenderCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *TemplateRenderCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *TemplateRenderCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagName = c.cmd.Flags().StringP("name", "n", "Example", "hexago template render <kind> -n <StructName>")
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago template render <kind> -o json|yaml")
+}
+
+func (c *TemplateRenderCommand) runner(cmd *cobra.Command, args []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	err = c.cfg.Load()
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("cfg.Load: %w", err)
+	}
+
+	var mode string
+	if len(args) > 1 {
+		mode = args[1]
+	}
+
+	files, err := c.projectService.RenderTemplate(cmd.Context(), model.RenderTemplateParams{
+		Kind:       args[0],
+		Mode:       mode,
+		StructName: *c.flagName,
+	})
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return fmt.Errorf("projectService.RenderTemplate: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, files)
+	}
+
+	for i, file := range files {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("// %s\n%s", file.Path, file.Content)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package templatecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*TemplateCommand)(nil)
+
+type TemplateCommand struct {
+	cmd *cobra.Command
+}
+
+func NewTemplateCommand() (*TemplateCommand, error) {
+	return &TemplateCommand{
+		cmd: &cobra.Command{
+			Use:     "template",
+			Example: "hexago template",
+			Short:   "Template processes",
+			Long:    `Template processes`,
+		},
+	}, nil
+}
+
+func (c *TemplateCommand) Command() *cobra.Command {
+	return c.cmd
+}
+
+func (c *TemplateCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
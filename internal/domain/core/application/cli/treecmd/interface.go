@@ -1,6 +1,10 @@
 package treecmd
 
-import "context"
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
 
 type ProjectService interface {
 	GetModuleName(modulePath ...string) (string, error)
@@ -10,5 +14,6 @@ type ProjectService interface {
 	GetAllApplications(ctx context.Context, targetDomain string) ([]string, error)
 	GetAllPackages(ctx context.Context, showGlobal bool) ([]string, error)
 	GetAllInfrastructures(ctx context.Context) ([]string, error)
-	GetAllPorts(ctx context.Context) ([]string, error)
+	GetAllPorts(ctx context.Context, targetDomain string) ([]model.Port, error)
+	GetProjectTree(ctx context.Context) (*model.ProjectTree, error)
 }
@@ -2,6 +2,7 @@ package treecmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -9,6 +10,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 	"github.com/ksckaan1/hexago/internal/port"
 )
@@ -19,13 +22,16 @@ type TreeCommand struct {
 	cmd            *cobra.Command
 	projectService ProjectService
 	tuilog         *tuilog.TUILog
+
+	// flags
+	flagOutput *string
 }
 
 func NewTreeCommand(projectService ProjectService, tl *tuilog.TUILog) (*TreeCommand, error) {
 	return &TreeCommand{
 		cmd: &cobra.Command{
 			Use:     "tree",
-			Example: "hexago tree",
+			Example: "hexago tree\nhexago tree -o json",
 			Short:   "Project structure tree",
 			Long:    `Project structure tree`,
 		},
@@ -51,9 +57,26 @@ func (c *TreeCommand) init() {
 		}
 		return nil
 	}
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago tree -o json|yaml")
 }
 
 func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	if format != output.FormatText {
+		projectTree, err2 := c.projectService.GetProjectTree(cmd.Context())
+		if err2 != nil {
+			c.tuilog.Error(err2.Error())
+			return fmt.Errorf("projectService.GetProjectTree: %w", err2)
+		}
+
+		return output.Print(os.Stdout, format, projectTree)
+	}
+
 	moduleName, err := c.projectService.GetModuleName("go.mod")
 	if err != nil {
 		return fmt.Errorf("projectService.GetModuleName: %w", err)
@@ -69,7 +92,12 @@ func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("projectService.GetAllDomains: %w", err)
 	}
 
-	domainTree := make([]any, len(domains))
+	ports, err := c.projectService.GetAllPorts(cmd.Context(), "")
+	if err != nil {
+		return fmt.Errorf("projectService.GetAllPorts: %w", err)
+	}
+
+	domainTree := make([]any, 0, len(domains))
 
 	for i := range domains {
 		services, err2 := c.projectService.GetAllServices(cmd.Context(), domains[i])
@@ -82,11 +110,15 @@ func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
 			return fmt.Errorf("projectService.GetAllApplications: %w", err2)
 		}
 
+		domainPorts := c.portNames(ports, domains[i])
+
 		domainTree = append(domainTree, tree.Root(domains[i]).Child(
 			tree.Root(c.title("Services", len(services))).
 				Child(c.colorizeElements(services)),
 			tree.Root(c.title("Applications", len(apps))).
 				Child(c.colorizeElements(apps)),
+			tree.Root(c.title("Ports", len(domainPorts))).
+				Child(c.colorizeElements(domainPorts)),
 		))
 	}
 
@@ -105,10 +137,7 @@ func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("projectService.GetAllInfrastructures: %w", err)
 	}
 
-	ports, err := c.projectService.GetAllPorts(cmd.Context())
-	if err != nil {
-		return fmt.Errorf("projectService.GetAllPorts: %w", err)
-	}
+	globalPorts := c.portNames(ports, "")
 
 	treePresentation := tree.Root(fmt.Sprintf("Project (%s)", moduleName)).Child(
 		tree.Root(c.title("Entry Points", len(entryPoints))).
@@ -124,8 +153,8 @@ func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
 				tree.Root(c.title("Internal", len(internalPackages))).
 					Child(c.colorizeElements(internalPackages)),
 			),
-		tree.Root(c.title("Ports", len(ports))).
-			Child(c.colorizeElements(ports)),
+		tree.Root(c.title("Ports", len(globalPorts))).
+			Child(c.colorizeElements(globalPorts)),
 	).String()
 
 	fmt.Println(treePresentation)
@@ -133,6 +162,14 @@ func (c *TreeCommand) runner(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// portNames returns the names of the ports which belong to the domain. An
+// empty domain means the ports in the port directory of the layout.
+func (c *TreeCommand) portNames(ports []model.Port, domain string) []string {
+	return lo.FilterMap(ports, func(p model.Port, _ int) (string, bool) {
+		return p.Name, p.Domain == domain
+	})
+}
+
 func (c *TreeCommand) colorizeElements(elems []string) []string {
 	renderer := lipgloss.NewStyle().Foreground(lipgloss.Color("#7571F9"))
 	return lo.Map(elems, func(item string, index int) string {
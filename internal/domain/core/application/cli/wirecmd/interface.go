@@ -0,0 +1,13 @@
+package wirecmd
+
+import (
+	"context"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+type ProjectService interface {
+	GetAllEntryPoints(ctx context.Context) ([]string, error)
+	WireEntryPoint(ctx context.Context, params model.WireEntryPointParams) (*model.WireResult, error)
+	DisplayPath(name string) string
+}
@@ -0,0 +1,179 @@
+package wirecmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/pkg/terminal"
+	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.Commander = (*WireCommand)(nil)
+
+type WireCommand struct {
+	cmd            *cobra.Command
+	tuilog         *tuilog.TUILog
+	projectService ProjectService
+
+	// flags
+	flagOutput *string
+}
+
+const wireLong = `wire command generates the providers.go file of an entry point, which collects the components for the DI framework of their template.
+
+do: every component which is constructed like New(i *do.Injector) (T, error) is provided as T.
+If T is a struct pointer, the component is also provided as each port which the struct is asserted as.
+Call provide(i) from main to use them.
+
+fx: the Module variables of the components are collected in an fx module per domain, like coreModule.
+The components out of the domains are collected in a module per layer. Pass modules to fx.New to use them.
+
+wire: the ProviderSet variables of the components are collected in a provider set per layer, like serviceSet.
+Pass sets to wire.Build in an injector to use them.
+
+The file is regenerated when the components change.`
+
+func NewWireCommand(projectService ProjectService, tl *tuilog.TUILog) (*WireCommand, error) {
+	return &WireCommand{
+		cmd: &cobra.Command{
+			Use:     "wire [entrypoint]",
+			Example: "hexago wire\nhexago wire api\nhexago wire api -o json",
+			Short:   "Generate do, fx or wire providers of an entry point",
+			Long:    wireLong,
+			Args:    cobra.MaximumNArgs(1),
+		},
+		projectService: projectService,
+		tuilog:         tl,
+	}, nil
+}
+
+func (c *WireCommand) Command() *cobra.Command {
+	c.init()
+	return c.cmd
+}
+
+func (c *WireCommand) AddSubCommand(cmd port.Commander) {
+	c.cmd.AddCommand(cmd.Command())
+}
+
+func (c *WireCommand) init() {
+	c.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := c.runner(cmd, args)
+		if err != nil {
+			return customerrors.ErrSuppressed
+		}
+		return nil
+	}
+	c.flagOutput = c.cmd.Flags().StringP("output", "o", "text", "hexago wire <entrypoint> -o json|yaml")
+}
+
+func (c *WireCommand) runner(cmd *cobra.Command, args []string) error {
+	format, err := output.ParseFormat(*c.flagOutput)
+	if err != nil {
+		c.tuilog.Error(err.Error())
+		return fmt.Errorf("output.ParseFormat: %w", err)
+	}
+
+	var entryPoint string
+	if len(args) > 0 {
+		entryPoint = args[0]
+	}
+
+	entryPoint, err = c.selectEntryPoint(cmd, entryPoint, format == output.FormatText && terminal.IsInteractive())
+	if err != nil {
+		return fmt.Errorf("select entry point: %w", err)
+	}
+
+	result, err := c.projectService.WireEntryPoint(cmd.Context(), model.WireEntryPointParams{
+		EntryPoint: entryPoint,
+	})
+	if err != nil {
+
+		if err2, ok := lo.ErrorsAs[customerrors.ErrDuplicateProvider](err); ok {
+			c.tuilog.Error(fmt.Sprintf("%s is provided by more than one component\n%s", err2.Type, strings.Join(err2.Components, "\n")))
+		} else if _, ok := lo.ErrorsAs[customerrors.ErrNoProviders](err); ok {
+			c.tuilog.Error("No component uses the do, fx or wire templates, nothing is generated\nSet templates in .hexago/config.yaml and create the components with them")
+		} else if err2, ok := lo.ErrorsAs[customerrors.ErrNotGenerated](err); ok {
+			c.tuilog.Error("File is not generated by hexago, remove or rename it first\n" + c.projectService.DisplayPath(err2.File))
+		} else {
+			c.tuilog.Error(err.Error())
+		}
+
+		return fmt.Errorf("projectService.WireEntryPoint: %w", err)
+	}
+
+	if format != output.FormatText {
+		return output.Print(os.Stdout, format, result)
+	}
+
+	if !result.Changed {
+		c.tuilog.Info("Already up to date\n"+c.projectService.DisplayPath(result.File), result.EntryPoint)
+		return nil
+	}
+
+	lines := lo.Map(result.Providers, func(provider model.Provider, _ int) string {
+		return fmt.Sprintf("%s <- %s", cmp.Or(provider.Type, provider.Group), provider.Component)
+	})
+
+	c.tuilog.Success(fmt.Sprintf("Providers generated\n%s\n\n%s", c.projectService.DisplayPath(result.File), strings.Join(lines, "\n")), result.EntryPoint)
+
+	return nil
+}
+
+func (c *WireCommand) selectEntryPoint(cmd *cobra.Command, entryPoint string, interactive bool) (string, error) {
+	entryPoints, err := c.projectService.GetAllEntryPoints(cmd.Context())
+	if err != nil {
+
+		c.tuilog.Error(err.Error())
+
+		return "", fmt.Errorf("projectService.GetAllEntryPoints: %w", err)
+	}
+
+	if len(entryPoints) == 0 {
+
+		c.tuilog.Error("No entry points found.\nAn entry point needs to be created first")
+
+		return "", errors.New("no entry points found")
+	}
+
+	switch {
+	case entryPoint != "":
+		if !slices.Contains(entryPoints, entryPoint) {
+			c.tuilog.Error("Entry point not found: " + entryPoint)
+			return "", fmt.Errorf("entry point not found: %s", entryPoint)
+		}
+	case len(entryPoints) == 1:
+		entryPoint = entryPoints[0]
+	case !interactive:
+		c.tuilog.Error("Entry point is required\nhexago wire <entrypoint>")
+		return "", errors.New("entry point is required")
+	default:
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[string]().
+					Title("Select an entry point.").
+					Options(
+						huh.NewOptions(entryPoints...)...,
+					).
+					Value(&entryPoint),
+			).WithShowHelp(true),
+		).Run()
+		if err != nil {
+			return "", fmt.Errorf("select an entry point: %w", err)
+		}
+	}
+
+	return entryPoint, nil
+}
@@ -0,0 +1,81 @@
+package model
+
+type ComponentKind string
+
+const (
+	KindDomain         ComponentKind = "domain"
+	KindService        ComponentKind = "service"
+	KindApplication    ComponentKind = "application"
+	KindInfrastructure ComponentKind = "infrastructure"
+	KindPackage        ComponentKind = "package"
+	KindEntryPoint     ComponentKind = "entrypoint"
+	KindPort           ComponentKind = "port"
+)
+
+const (
+	ScopeInternal = "internal"
+	ScopeGlobal   = "global"
+)
+
+type Component struct {
+	Kind   ComponentKind `json:"kind" yaml:"kind"`
+	Name   string        `json:"name" yaml:"name"`
+	Domain string        `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Path   string        `json:"path" yaml:"path"`
+	Scope  string        `json:"scope,omitempty" yaml:"scope,omitempty"`
+}
+
+type ProjectTree struct {
+	Module          string       `json:"module" yaml:"module"`
+	EntryPoints     []Component  `json:"entrypoints" yaml:"entrypoints"`
+	Domains         []DomainTree `json:"domains" yaml:"domains"`
+	Infrastructures []Component  `json:"infrastructures" yaml:"infrastructures"`
+	Packages        []Component  `json:"packages" yaml:"packages"`
+	Ports           []Component  `json:"ports" yaml:"ports"`
+}
+
+type DomainTree struct {
+	Name         string      `json:"name" yaml:"name"`
+	Path         string      `json:"path" yaml:"path"`
+	Services     []Component `json:"services" yaml:"services"`
+	Applications []Component `json:"applications" yaml:"applications"`
+	Ports        []Component `json:"ports" yaml:"ports"`
+}
+
+type RemoveComponentParams struct {
+	Kind         ComponentKind
+	Name         string
+	TargetDomain string
+	IsGlobal     bool
+}
+
+type RemovalPlan struct {
+	Component Component  `json:"component" yaml:"component"`
+	Files     []string   `json:"files" yaml:"files"`
+	Importers []Importer `json:"importers" yaml:"importers"`
+	Runners   []string   `json:"runners" yaml:"runners"`
+}
+
+type Importer struct {
+	Package string `json:"package" yaml:"package"`
+	File    string `json:"file" yaml:"file"`
+	Line    int    `json:"line" yaml:"line"`
+}
+
+type MoveComponentParams struct {
+	Kind          ComponentKind
+	Name          string
+	TargetDomain  string
+	IsGlobal      bool
+	NewName       string
+	NewDomain     string
+	NewStructName string
+}
+
+type MoveResult struct {
+	From         Component `json:"from" yaml:"from"`
+	To           Component `json:"to" yaml:"to"`
+	StructName   string    `json:"struct_name,omitempty" yaml:"struct_name,omitempty"`
+	UpdatedFiles []string  `json:"updated_files" yaml:"updated_files"`
+	SkippedFiles []string  `json:"skipped_files,omitempty" yaml:"skipped_files,omitempty"`
+}
@@ -0,0 +1,19 @@
+package model
+
+// CustomKind is a component kind declared in the config.
+type CustomKind struct {
+	Name      string `json:"name" yaml:"name"`
+	Dir       string `json:"dir" yaml:"dir"`
+	PerDomain bool   `json:"per_domain" yaml:"per_domain"`
+	Suffix    string `json:"suffix,omitempty" yaml:"suffix,omitempty"`
+	Template  string `json:"template" yaml:"template"`
+}
+
+type CreateCustomComponentParams struct {
+	Kind            string
+	TargetDomain    string
+	StructName      string
+	PackageName     string
+	PortParams      []string
+	AssertInterface bool
+}
@@ -0,0 +1,53 @@
+package model
+
+// Port is a component whose path is the file which declares the interface.
+// The fields of the component are kept at the top level of the json and yaml
+// outputs.
+type Port struct {
+	Component  `yaml:",inline"`
+	Package    string       `json:"package" yaml:"package"`
+	ImportPath string       `json:"import_path" yaml:"import_path"`
+	Doc        string       `json:"doc,omitempty" yaml:"doc,omitempty"`
+	TypeParams string       `json:"type_params,omitempty" yaml:"type_params,omitempty"`
+	Embeds     []string     `json:"embeds,omitempty" yaml:"embeds,omitempty"`
+	Methods    []PortMethod `json:"methods" yaml:"methods"`
+}
+
+type PortMethod struct {
+	Name      string `json:"name" yaml:"name"`
+	Signature string `json:"signature" yaml:"signature"`
+	Doc       string `json:"doc,omitempty" yaml:"doc,omitempty"`
+}
+
+type SyncPortParams struct {
+	TargetDomain string
+	PortParam    string
+}
+
+type PortSyncResult struct {
+	Port           string        `json:"port" yaml:"port"`
+	Implementer    string        `json:"implementer" yaml:"implementer"`
+	File           string        `json:"file" yaml:"file"`
+	AddedMethods   []string      `json:"added_methods" yaml:"added_methods"`
+	DriftedMethods []MethodDrift `json:"drifted_methods" yaml:"drifted_methods"`
+}
+
+type MethodDrift struct {
+	Name string `json:"name" yaml:"name"`
+	Have string `json:"have" yaml:"have"`
+	Want string `json:"want" yaml:"want"`
+}
+
+type GenerateMocksParams struct {
+	TargetDomain string
+	PortName     string
+	All          bool
+	Style        string
+}
+
+type GeneratedMock struct {
+	Port   string `json:"port" yaml:"port"`
+	Domain string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Style  string `json:"style" yaml:"style"`
+	File   string `json:"file" yaml:"file"`
+}
@@ -14,16 +14,18 @@ type CreateServiceParams struct {
 	TargetDomain    string
 	StructName      string
 	PackageName     string
-	PortParam       string
+	PortParams      []string
 	AssertInterface bool
+	Dependencies    []string
 }
 
 type CreateApplicationParams struct {
 	TargetDomain    string
 	StructName      string
 	PackageName     string
-	PortParam       string
+	PortParams      []string
 	AssertInterface bool
+	Dependencies    []string
 }
 
 type CreateEntryPointParams struct {
@@ -33,25 +35,40 @@ type CreateEntryPointParams struct {
 type CreateInfraParams struct {
 	StructName      string
 	PackageName     string
-	PortParam       string
+	PortParams      []string
 	AssertInterface bool
 }
 
 type CreatePackageParams struct {
 	StructName      string
 	PackageName     string
-	PortParam       string
+	PortParams      []string
 	AssertInterface bool
 	IsGlobal        bool
 }
 
+type CreatePortParams struct {
+	TargetDomain string
+	PortName     string
+	FileName     string
+	Methods      []string
+}
+
 type DoctorResult struct {
-	OSResult   string
-	GoResult   Tool
-	ImplResult Tool
+	OSResult string
+	GoResult Tool
 }
 
 type Tool struct {
 	Output      string
 	IsInstalled bool
 }
+
+type LintViolation struct {
+	Rule    string `json:"rule" yaml:"rule"`
+	File    string `json:"file" yaml:"file"`
+	Line    int    `json:"line" yaml:"line"`
+	Column  int    `json:"column" yaml:"column"`
+	Package string `json:"package" yaml:"package"`
+	Import  string `json:"import" yaml:"import"`
+}
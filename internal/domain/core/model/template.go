@@ -0,0 +1,32 @@
+package model
+
+type Template struct {
+	Kind     string `json:"kind" yaml:"kind"`
+	Mode     string `json:"mode" yaml:"mode"`
+	BuiltIn  bool   `json:"built_in" yaml:"built_in"`
+	Path     string `json:"path,omitempty" yaml:"path,omitempty"`
+	Selected bool   `json:"selected" yaml:"selected"`
+}
+
+type EjectTemplateParams struct {
+	Kind string
+	Mode string
+	Name string
+}
+
+type RenderTemplateParams struct {
+	Kind       string
+	Mode       string
+	StructName string
+}
+
+type RenderedFile struct {
+	Path    string `json:"path" yaml:"path"`
+	Content string `json:"content" yaml:"content"`
+}
+
+type TemplateCheck struct {
+	Template Template `json:"template" yaml:"template"`
+	Files    []string `json:"files" yaml:"files"`
+	Error    string   `json:"error,omitempty" yaml:"error,omitempty"`
+}
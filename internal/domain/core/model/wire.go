@@ -0,0 +1,18 @@
+package model
+
+type WireEntryPointParams struct {
+	EntryPoint string
+}
+
+type WireResult struct {
+	EntryPoint string     `json:"entry_point" yaml:"entry_point"`
+	File       string     `json:"file" yaml:"file"`
+	Changed    bool       `json:"changed" yaml:"changed"`
+	Providers  []Provider `json:"providers" yaml:"providers"`
+}
+
+type Provider struct {
+	Type      string `json:"type,omitempty" yaml:"type,omitempty"`   // type which the component is provided as, for do
+	Component string `json:"component" yaml:"component"`             // import path of the component
+	Group     string `json:"group,omitempty" yaml:"group,omitempty"` // fx module or wire set which the component is collected in
+}
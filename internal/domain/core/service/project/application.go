@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -20,15 +19,15 @@ func (p *Project) GetAllApplications(ctx context.Context, targetDomain string) (
 		return nil, fmt.Errorf("is domain exist: %w", err)
 	}
 
-	applicationsPath := filepath.Join("internal", "domain", targetDomain, "application")
+	applicationsPath := p.applicationsDir(targetDomain)
 
-	applicationCandidatePaths, err := filepath.Glob(filepath.Join(applicationsPath, "*"))
+	applicationCandidatePaths, err := p.glob(filepath.Join(applicationsPath, "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	applicationPaths := lo.Filter(applicationCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -77,34 +76,31 @@ func (p *Project) CreateApplication(ctx context.Context, params model.CreateAppl
 		return "", fmt.Errorf("is application exist: %w", customerrors.ErrAlreadyExist)
 	}
 
-	applicationDir := filepath.Join("internal", "domain", params.TargetDomain, "application", params.PackageName)
+	applicationDir := filepath.Join(p.applicationsDir(params.TargetDomain), params.PackageName)
 
-	err = os.MkdirAll(applicationDir, 0o755)
+	err = p.fs.MkdirAll(applicationDir, 0o755)
 	if err != nil {
-		return "", fmt.Errorf("os: mkdir all: %w", err)
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
-	applicationFile, err := p.generateGoInitFile(
+	applicationFile, err := p.generateComponentFiles(
 		ctx,
 		applicationDir,
+		params.TargetDomain,
 		params.StructName,
 		params.PackageName,
-		params.PortParam,
+		params.PortParams,
 		TTApplication,
 		params.AssertInterface,
+		params.Dependencies,
 	)
 	if err != nil {
-		err2 := os.RemoveAll(applicationDir)
+		err2 := p.fs.RemoveAll(applicationDir)
 		if err2 != nil {
-			return "", fmt.Errorf("os: remove all: %w", err2)
+			return "", fmt.Errorf("fs: remove all: %w", err2)
 		}
 		return "", fmt.Errorf("generate application file: %w", err)
 	}
 
-	err = p.formatGoFiles(ctx, applicationFile)
-	if err != nil {
-		return "", fmt.Errorf("format go files: %w", err)
-	}
-
 	return applicationFile, nil
 }
@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 
@@ -13,14 +12,16 @@ import (
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
 )
 
+const cobraModulePath = "github.com/spf13/cobra"
+
 func (p *Project) GetAllEntryPoints(_ context.Context) ([]string, error) {
-	cmdCandidatePaths, err := filepath.Glob(filepath.Join("cmd", "*"))
+	cmdCandidatePaths, err := p.glob(filepath.Join(p.entryPointsDir(), "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	cmdPaths := lo.Filter(cmdCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -55,28 +56,41 @@ func (p *Project) CreateEntryPoint(ctx context.Context, params model.CreateEntry
 		return "", fmt.Errorf("is entry point exist: %w", customerrors.ErrAlreadyExist)
 	}
 
-	entryPointPath := filepath.Join("cmd", params.PackageName)
-
-	err = os.MkdirAll(entryPointPath, 0o755)
-	if err != nil {
-		return "", fmt.Errorf("os: mkdir all: %w", err)
-	}
-
-	cmdFile, err := assets.ReadFile("assets/templates/cmd.tmpl")
-	if err != nil {
-		return "", fmt.Errorf("assets: read file: %w", err)
+	// cobra entry points do not build unless the module requires cobra
+	if p.cfg.GetEntryPointTemplate() == "cobra" {
+		ok, err2 := p.requiresModule(cobraModulePath)
+		if err2 != nil {
+			return "", fmt.Errorf("requires module: %w", err2)
+		}
+		if !ok {
+			return "", customerrors.ErrMissingRequirement{Module: cobraModulePath}
+		}
 	}
 
-	cmdFilePath := filepath.Join(entryPointPath, "main.go")
+	entryPointPath := filepath.Join(p.entryPointsDir(), params.PackageName)
 
-	err = os.WriteFile(cmdFilePath, cmdFile, 0o600)
+	err = p.fs.MkdirAll(entryPointPath, 0o755)
 	if err != nil {
-		return "", fmt.Errorf("os: write file: %w", err)
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
-	err = p.formatGoFiles(ctx, cmdFilePath)
+	cmdFilePath, err := p.generateComponentFiles(
+		ctx,
+		entryPointPath,
+		"",
+		toPascalCase(params.PackageName),
+		"main",
+		nil,
+		TTCmd,
+		false,
+		nil,
+	)
 	if err != nil {
-		return "", fmt.Errorf("format go files: %w", err)
+		err2 := p.fs.RemoveAll(entryPointPath)
+		if err2 != nil {
+			return "", fmt.Errorf("fs: remove all: %w", err2)
+		}
+		return "", fmt.Errorf("generate entry point file: %w", err)
 	}
 
 	return cmdFilePath, nil
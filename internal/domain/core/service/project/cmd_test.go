@@ -11,9 +11,12 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 )
 
 func TestCreateEntryPoint(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -37,7 +40,7 @@ func TestCreateEntryPoint(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -59,7 +62,7 @@ func TestCreateEntryPoint(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -90,7 +93,7 @@ func TestCreateEntryPoint(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -113,8 +116,14 @@ func TestCreateEntryPoint(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -126,6 +135,8 @@ func TestCreateEntryPoint(t *testing.T) {
 }
 
 func TestGetAllEntryPoints(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -149,7 +160,7 @@ func TestGetAllEntryPoints(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -183,7 +194,7 @@ func TestGetAllEntryPoints(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -202,8 +213,14 @@ func TestGetAllEntryPoints(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -213,3 +230,105 @@ func TestGetAllEntryPoints(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateEntryPointTemplates(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		cfg      string
+		files    map[string]string
+		requires []string
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		contains []string
+	}
+
+	tests := []struct {
+		name string
+		in
+		want
+	}{
+		{
+			name: "std",
+			in:   in{cfg: "templates:\n  cmd: std\n"},
+			want: want{contains: []string{"package main", `fmt.Printf("Hello from %s!\n"`}},
+		},
+		{
+			name: "http",
+			in:   in{cfg: "templates:\n  cmd: http\n"},
+			want: want{contains: []string{"server.Shutdown(shutdownCtx)", `slog.Info("my-api is listening", "addr", addr)`}},
+		},
+		{
+			name: "cobra",
+			in: in{
+				cfg:      "templates:\n  cmd: cobra\n",
+				requires: []string{"github.com/spf13/cobra v1.8.1"},
+			},
+			want: want{contains: []string{`"github.com/spf13/cobra"`, `Use:          "my-api",`}},
+		},
+		{
+			name: "cobra is not required",
+			in:   in{cfg: "templates:\n  cmd: cobra\n"},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					target := customerrors.ErrMissingRequirement{}
+					require.ErrorAs(t, err, &target)
+					require.Equal(t, "github.com/spf13/cobra", target.Module)
+				},
+			},
+		},
+		{
+			name: "worker",
+			in:   in{cfg: "templates:\n  cmd: worker\n"},
+			want: want{contains: []string{"time.NewTicker(time.Minute)", `slog.Info("my-api started")`}},
+		},
+		{
+			name: "custom",
+			in: in{
+				cfg: "templates:\n  cmd: abc\n",
+				files: map[string]string{
+					"abc_cmd.tmpl": "package {{.PkgName}}\n\n// {{.EntryPoint}} {{.StructName}} {{.ModulePath}} {{.PkgPath}}\nfunc main() {}\n",
+				},
+			},
+			want: want{contains: []string{"// my-api MyApi my-project my-project/cmd/my-api"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := make(map[string]string, len(tt.in.files))
+			for name, content := range tt.in.files {
+				files[filepath.Join(".hexago", "templates", name)] = content
+			}
+
+			projectService := newTemplateTestProject(t, tt.in.cfg, files)
+
+			for _, r := range tt.in.requires {
+				goMod, err2 := projectService.fs.ReadFile("go.mod")
+				require.NoError(t, err2)
+				require.NoError(t, projectService.fs.WriteFile("go.mod", append(goMod, "\nrequire "+r+"\n"...), 0o644))
+			}
+
+			cmdFilePath, err := projectService.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{
+				PackageName: "my-api",
+			})
+			if tt.want.err != nil {
+				tt.want.err(t, err)
+				require.NoDirExists(t, filepath.Join(projectService.root, "cmd", "my-api"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join("cmd", "my-api", "main.go"), cmdFilePath)
+
+			content, err := projectService.fs.ReadFile(cmdFilePath)
+			require.NoError(t, err)
+
+			for _, substr := range tt.want.contains {
+				require.Contains(t, string(content), substr)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/samber/lo"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+// GetAllComponents returns structured records of the given kind. For services
+// and applications, an empty or "*" target domain means all domains.
+func (p *Project) GetAllComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error) {
+	switch kind {
+	case model.KindDomain:
+		domains, err := p.GetAllDomains(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get all domains: %w", err)
+		}
+		return lo.Map(domains, func(d string, _ int) model.Component {
+			return model.Component{
+				Kind: model.KindDomain,
+				Name: d,
+				Path: p.domainDir(d),
+			}
+		}), nil
+	case model.KindService, model.KindApplication:
+		return p.getDomainComponents(ctx, kind, targetDomain)
+	case model.KindInfrastructure:
+		infras, err := p.GetAllInfrastructures(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get all infrastructures: %w", err)
+		}
+		return lo.Map(infras, func(i string, _ int) model.Component {
+			return model.Component{
+				Kind: model.KindInfrastructure,
+				Name: i,
+				Path: filepath.Join(p.infrastructuresDir(), i),
+			}
+		}), nil
+	case model.KindPackage:
+		return p.getPackageComponents(ctx)
+	case model.KindEntryPoint:
+		entryPoints, err := p.GetAllEntryPoints(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get all entry points: %w", err)
+		}
+		return lo.Map(entryPoints, func(e string, _ int) model.Component {
+			return model.Component{
+				Kind: model.KindEntryPoint,
+				Name: e,
+				Path: filepath.Join(p.entryPointsDir(), e),
+			}
+		}), nil
+	case model.KindPort:
+		ports, err := p.GetAllPorts(ctx, targetDomain)
+		if err != nil {
+			return nil, fmt.Errorf("get all ports: %w", err)
+		}
+		return lo.Map(ports, func(port model.Port, _ int) model.Component {
+			return port.Component
+		}), nil
+	default:
+		k, err := p.getCustomKind(ctx, string(kind))
+		if err != nil {
+			return nil, fmt.Errorf("invalid component kind: %s: %w", kind, err)
+		}
+		return p.getCustomComponents(ctx, *k, targetDomain)
+	}
+}
+
+func (p *Project) getDomainComponents(ctx context.Context, kind model.ComponentKind, targetDomain string) ([]model.Component, error) {
+	domains := []string{targetDomain}
+
+	if targetDomain == "" || targetDomain == "*" {
+		var err error
+		domains, err = p.GetAllDomains(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get all domains: %w", err)
+		}
+	}
+
+	components := make([]model.Component, 0)
+
+	for _, domain := range domains {
+		var (
+			names []string
+			dir   string
+			err   error
+		)
+
+		if kind == model.KindService {
+			names, err = p.GetAllServices(ctx, domain)
+			dir = p.servicesDir(domain)
+		} else {
+			names, err = p.GetAllApplications(ctx, domain)
+			dir = p.applicationsDir(domain)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get all %ss: %w", kind, err)
+		}
+
+		for _, name := range names {
+			components = append(components, model.Component{
+				Kind:   kind,
+				Name:   name,
+				Domain: domain,
+				Path:   filepath.Join(dir, name),
+			})
+		}
+	}
+
+	return components, nil
+}
+
+func (p *Project) getPackageComponents(ctx context.Context) ([]model.Component, error) {
+	globalPackages, err := p.GetAllPackages(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("get all packages: %w", err)
+	}
+
+	internalPackages, err := p.GetAllPackages(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("get all packages: %w", err)
+	}
+
+	components := make([]model.Component, 0, len(globalPackages)+len(internalPackages))
+
+	for _, pkg := range globalPackages {
+		components = append(components, model.Component{
+			Kind:  model.KindPackage,
+			Name:  pkg,
+			Path:  filepath.Join(p.packagesDir(true), pkg),
+			Scope: model.ScopeGlobal,
+		})
+	}
+
+	for _, pkg := range internalPackages {
+		components = append(components, model.Component{
+			Kind:  model.KindPackage,
+			Name:  pkg,
+			Path:  filepath.Join(p.packagesDir(false), pkg),
+			Scope: model.ScopeInternal,
+		})
+	}
+
+	return components, nil
+}
+
+// GetProjectTree returns the whole nested project model.
+func (p *Project) GetProjectTree(ctx context.Context) (*model.ProjectTree, error) {
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	entryPoints, err := p.GetAllComponents(ctx, model.KindEntryPoint, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	domains, err := p.GetAllComponents(ctx, model.KindDomain, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	ports, err := p.GetAllComponents(ctx, model.KindPort, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	domainTrees := make([]model.DomainTree, 0, len(domains))
+
+	for _, domain := range domains {
+		services, err2 := p.GetAllComponents(ctx, model.KindService, domain.Name)
+		if err2 != nil {
+			return nil, fmt.Errorf("get all components: %w", err2)
+		}
+
+		apps, err2 := p.GetAllComponents(ctx, model.KindApplication, domain.Name)
+		if err2 != nil {
+			return nil, fmt.Errorf("get all components: %w", err2)
+		}
+
+		domainTrees = append(domainTrees, model.DomainTree{
+			Name:         domain.Name,
+			Path:         domain.Path,
+			Services:     services,
+			Applications: apps,
+			Ports:        p.filterDomainComponents(ports, domain.Name),
+		})
+	}
+
+	infras, err := p.GetAllComponents(ctx, model.KindInfrastructure, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	packages, err := p.GetAllComponents(ctx, model.KindPackage, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	return &model.ProjectTree{
+		Module:          moduleName,
+		EntryPoints:     entryPoints,
+		Domains:         domainTrees,
+		Infrastructures: infras,
+		Packages:        packages,
+		Ports:           p.filterDomainComponents(ports, ""),
+	}, nil
+}
+
+// filterDomainComponents returns the components which belong to the domain.
+// An empty domain means the components which are not in a domain.
+func (*Project) filterDomainComponents(components []model.Component, domain string) []model.Component {
+	return lo.Filter(components, func(c model.Component, _ int) bool {
+		return c.Domain == domain
+	})
+}
@@ -0,0 +1,198 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func TestGetAllComponents(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		preRun func(p *Project) error
+	}
+	type args struct {
+		ctx          func() context.Context
+		kind         model.ComponentKind
+		targetDomain string
+	}
+	type want struct {
+		err        require.ErrorAssertionFunc
+		components []model.Component
+	}
+
+	initProject := func(p *Project) error {
+		return p.InitNewProject(context.Background(), model.InitNewProjectParams{
+			ProjectDirectory: ".",
+			ModuleName:       "my-project",
+			CreateModule:     true,
+		})
+	}
+
+	tests := []struct {
+		name string
+		in
+		args
+		want
+	}{
+		{
+			name: "domains",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				ctx:  context.Background,
+				kind: model.KindDomain,
+			},
+			want: want{
+				err: require.NoError,
+				components: []model.Component{
+					{Kind: model.KindDomain, Name: "core", Path: filepath.Join("internal", "domain", "core")},
+				},
+			},
+		},
+		{
+			name: "services of all domains",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = p.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"})
+					if err != nil {
+						return err
+					}
+					_, err = p.CreateService(context.Background(), model.CreateServiceParams{
+						TargetDomain: "billing",
+						StructName:   "Invoice",
+					})
+					return err
+				},
+			},
+			args: args{
+				ctx:          context.Background,
+				kind:         model.KindService,
+				targetDomain: "*",
+			},
+			want: want{
+				err: require.NoError,
+				components: []model.Component{
+					{
+						Kind:   model.KindService,
+						Name:   "invoice",
+						Domain: "billing",
+						Path:   filepath.Join("internal", "domain", "billing", "service", "invoice"),
+					},
+				},
+			},
+		},
+		{
+			name: "packages with scope",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					_, err = p.CreatePackage(context.Background(), model.CreatePackageParams{
+						StructName: "Global",
+						IsGlobal:   true,
+					})
+					if err != nil {
+						return err
+					}
+					_, err = p.CreatePackage(context.Background(), model.CreatePackageParams{
+						StructName: "Internal",
+					})
+					return err
+				},
+			},
+			args: args{
+				ctx:  context.Background,
+				kind: model.KindPackage,
+			},
+			want: want{
+				err: require.NoError,
+				components: []model.Component{
+					{Kind: model.KindPackage, Name: "global", Path: filepath.Join("pkg", "global"), Scope: model.ScopeGlobal},
+					{Kind: model.KindPackage, Name: "internal", Path: filepath.Join("internal", "pkg", "internal"), Scope: model.ScopeInternal},
+				},
+			},
+		},
+		{
+			name: "invalid kind",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				ctx:  context.Background,
+				kind: "unknown",
+			},
+			want: want{
+				err:        require.Error,
+				components: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
+			require.NoError(t, tt.in.preRun(projectService))
+
+			components, err := projectService.GetAllComponents(tt.args.ctx(), tt.args.kind, tt.args.targetDomain)
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.components, components)
+		})
+	}
+}
+
+func TestGetProjectTree(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	projectService := &Project{
+		cfg:  &config.Config{},
+		root: root,
+		fs:   filesystem.NewOS(root),
+	}
+
+	err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	_, err = projectService.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{
+		PackageName: "api",
+	})
+	require.NoError(t, err)
+
+	projectTree, err := projectService.GetProjectTree(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "my-project", projectTree.Module)
+	require.Len(t, projectTree.EntryPoints, 1)
+	require.Equal(t, "api", projectTree.EntryPoints[0].Name)
+	require.Len(t, projectTree.Domains, 1)
+	require.Equal(t, "core", projectTree.Domains[0].Name)
+	require.Empty(t, projectTree.Domains[0].Services)
+}
@@ -0,0 +1,101 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"go/types"
+	"maps"
+)
+
+// Dependency is a port which a component depends on. It becomes a field of
+// the struct and a parameter of the constructor.
+type Dependency struct {
+	Name          string // name of the field and the parameter, e.g. userRepository
+	Type          string // qualified type of the port, e.g. port.UserRepository
+	InterfaceName string // name of the port, e.g. UserRepository
+	ImportPath    string // import path of the port
+	ImportName    string // package name which the port is referred with
+}
+
+// reservedDependencyNames can not be used as parameter names, since the
+// built-in templates refer to them in the constructors. The names of
+// templateImports are reserved as well.
+var reservedDependencyNames = []string{"i", "err"}
+
+// resolveDependencies resolves the ports which the component depends on. The
+// packages of the ports are added to the imports, and they are aliased if
+// their names collide with the ones which are already imported.
+func (p *Project) resolveDependencies(ctx context.Context, targetPkgPath, targetDomain string, dependencyParams []string, imports map[string]string) ([]Dependency, map[string]string, error) {
+	if len(dependencyParams) == 0 {
+		return nil, imports, nil
+	}
+
+	g := &stubGenerator{
+		targetPkgPath: targetPkgPath,
+		imports:       make(map[string]string),
+		names:         make(map[string]string),
+	}
+
+	maps.Copy(g.imports, imports)
+	for importPath, name := range imports {
+		g.names[name] = importPath
+	}
+	for importPath, name := range templateImports {
+		if _, ok := g.names[name]; !ok {
+			g.names[name] = importPath
+		}
+	}
+	for _, name := range reservedDependencyNames {
+		if _, ok := g.names[name]; !ok {
+			g.names[name] = name
+		}
+	}
+
+	dependencies := make([]Dependency, 0, len(dependencyParams))
+
+	for _, dependencyParam := range dependencyParams {
+		interfaceInfo, err := p.getInterfaceInfo(ctx, dependencyParam, targetDomain)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get interface info: %w", err)
+		}
+
+		pkg, err := p.loadPackage(ctx, interfaceInfo.ImportPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load package: %w", err)
+		}
+
+		ifaceType, err := p.lookupInterface(pkg, interfaceInfo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("lookup interface: %w", err)
+		}
+
+		dependencies = append(dependencies, Dependency{
+			Type:          types.TypeString(ifaceType, g.qualifier),
+			InterfaceName: interfaceInfo.InterfaceName,
+			ImportPath:    interfaceInfo.ImportPath,
+			ImportName:    g.imports[interfaceInfo.ImportPath],
+		})
+	}
+
+	// names are given after all packages are qualified, so a parameter does
+	// not shadow a package which is referred by another parameter
+	taken := make(map[string]bool)
+	for name := range g.names {
+		taken[name] = true
+	}
+
+	for i := range dependencies {
+		base := toCamelCase(dependencies[i].InterfaceName)
+
+		name := base
+		for n := 2; taken[name] || token.IsKeyword(name); n++ {
+			name = fmt.Sprintf("%s%d", base, n)
+		}
+
+		taken[name] = true
+		dependencies[i].Name = name
+	}
+
+	return dependencies, g.imports, nil
+}
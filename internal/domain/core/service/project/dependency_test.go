@@ -0,0 +1,172 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+func TestCreateServiceWithDependencies(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		cfg    string
+		params model.CreateServiceParams
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		contains []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "std",
+			args: args{params: model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "UserService",
+				PackageName:  "userservice",
+				Dependencies: []string{"UserStore", "Clock"},
+			}},
+			want: want{
+				err: require.NoError,
+				contains: []string{
+					`"errors"`,
+					`"my-project/internal/domain/core/port"`,
+					"userStore port.UserStore",
+					"clock     port.Clock",
+					"func New(userStore port.UserStore, clock port.Clock) (*UserService, error) {",
+					`return nil, errors.New("userStore is nil")`,
+					`return nil, errors.New("clock is nil")`,
+				},
+			},
+		},
+		{
+			name: "do",
+			args: args{
+				cfg: "templates:\n  service: do\n",
+				params: model.CreateServiceParams{
+					TargetDomain: "core",
+					StructName:   "UserService",
+					PackageName:  "userservice",
+					Dependencies: []string{"UserStore"},
+				},
+			},
+			want: want{
+				err: require.NoError,
+				contains: []string{
+					`"fmt"`,
+					"userStore, err := do.Invoke[port.UserStore](i)",
+					`return nil, fmt.Errorf("invoke UserStore: %w", err)`,
+				},
+			},
+		},
+		{
+			name: "implemented port is not shadowed",
+			args: args{params: model.CreateServiceParams{
+				TargetDomain:    "core",
+				StructName:      "UserService",
+				PackageName:     "userservice",
+				PortParams:      []string{"UserStore"},
+				AssertInterface: true,
+				Dependencies:    []string{"UserStore"},
+			}},
+			want: want{
+				err: require.NoError,
+				contains: []string{
+					"var _ port.UserStore = (*UserService)(nil)",
+					"func New(userStore port.UserStore) (*UserService, error) {",
+				},
+			},
+		},
+		{
+			name: "multiple implemented ports",
+			args: args{params: model.CreateServiceParams{
+				TargetDomain:    "core",
+				StructName:      "UserService",
+				PackageName:     "userservice",
+				PortParams:      []string{"UserStore", "Clock"},
+				AssertInterface: true,
+				Dependencies:    []string{"Clock"},
+			}},
+			want: want{
+				err: require.NoError,
+				contains: []string{
+					"var _ port.UserStore = (*UserService)(nil)",
+					"var _ port.Clock = (*UserService)(nil)",
+					"func (u *UserService) Get(id string) (string, error) {",
+					"func (u *UserService) Now() time.Time {",
+					`"time"`,
+				},
+			},
+		},
+		{
+			name: "standard library interfaces",
+			args: args{params: model.CreateServiceParams{
+				TargetDomain:    "core",
+				StructName:      "UserService",
+				PackageName:     "userservice",
+				PortParams:      []string{"io.ReadWriteCloser", "net/http.Handler"},
+				AssertInterface: true,
+			}},
+			want: want{
+				err: require.NoError,
+				contains: []string{
+					`"io"`,
+					`"net/http"`,
+					"var _ io.ReadWriteCloser = (*UserService)(nil)",
+					"var _ http.Handler = (*UserService)(nil)",
+					"func (u *UserService) ServeHTTP(http.ResponseWriter, *http.Request) {",
+				},
+			},
+		},
+		{
+			name: "dependency not found",
+			args: args{params: model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "UserService",
+				PackageName:  "userservice",
+				Dependencies: []string{"Mailer"},
+			}},
+			want: want{err: require.Error},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newTemplateTestProject(t, tt.args.cfg, nil)
+
+			for _, portParams := range []model.CreatePortParams{
+				{TargetDomain: "core", PortName: "UserStore", FileName: "user", Methods: []string{"Get(id string) (string, error)"}},
+				{TargetDomain: "core", PortName: "Clock", FileName: "clock", Methods: []string{"Now() time.Time"}},
+			} {
+				_, err := projectService.CreatePort(context.Background(), portParams)
+				require.NoError(t, err)
+			}
+
+			serviceFile, err := projectService.CreateService(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			if err != nil {
+				_, err = projectService.fs.Stat(filepath.Join("internal", "domain", "core", "service", tt.args.params.PackageName))
+				require.Error(t, err)
+				return
+			}
+
+			content, err := projectService.fs.ReadFile(serviceFile)
+			require.NoError(t, err)
+
+			for _, substr := range tt.want.contains {
+				require.Contains(t, string(content), substr)
+			}
+		})
+	}
+}
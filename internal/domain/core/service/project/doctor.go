@@ -18,15 +18,9 @@ func (p *Project) Doctor(ctx context.Context) (*model.DoctorResult, error) {
 		return nil, fmt.Errorf("check command: %w", err)
 	}
 
-	implCommand, err := p.isToolInstalled(ctx, "impl Murmur hash.Hash")
-	if err != nil {
-		return nil, fmt.Errorf("check command: %w", err)
-	}
-
 	return &model.DoctorResult{
-		OSResult:   fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
-		GoResult:   goCommand,
-		ImplResult: implCommand,
+		OSResult: fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+		GoResult: goCommand,
 	}, nil
 }
 
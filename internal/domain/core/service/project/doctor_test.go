@@ -22,5 +22,4 @@ func TestDoctor(t *testing.T) {
 
 	require.NotEmpty(t, result.OSResult)
 	require.True(t, result.GoResult.IsInstalled, result.GoResult.Output)
-	require.True(t, result.ImplResult.IsInstalled, result.ImplResult.Output)
 }
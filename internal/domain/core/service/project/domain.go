@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 
@@ -14,15 +13,15 @@ import (
 )
 
 func (p *Project) GetAllDomains(_ context.Context) ([]string, error) {
-	domainLocation := filepath.Join("internal", "domain")
+	domainLocation := p.domainsDir()
 
-	domainCandidatePaths, err := filepath.Glob(filepath.Join(domainLocation, "*"))
+	domainCandidatePaths, err := p.glob(filepath.Join(domainLocation, "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	domainPaths := lo.Filter(domainCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -57,20 +56,20 @@ func (p *Project) CreateDomain(ctx context.Context, params model.CreateDomainPar
 		return fmt.Errorf("validate pkg name: %w", err)
 	}
 
-	domainPath := filepath.Join("internal", "domain", params.DomainName)
+	domainPath := p.domainDir(params.DomainName)
 
 	domainDirs := []string{
-		filepath.Join(domainPath, "application"),
+		p.applicationsDir(params.DomainName),
 		filepath.Join(domainPath, "dto"),
 		filepath.Join(domainPath, "model"),
-		filepath.Join(domainPath, "port"),
-		filepath.Join(domainPath, "service"),
+		p.domainPortsDir(params.DomainName),
+		p.servicesDir(params.DomainName),
 	}
 
 	for i := range domainDirs {
-		err = os.MkdirAll(domainDirs[i], 0o755)
+		err = p.fs.MkdirAll(domainDirs[i], 0o755)
 		if err != nil {
-			return fmt.Errorf("os: mkdir all: %w", err)
+			return fmt.Errorf("fs: mkdir all: %w", err)
 		}
 	}
 
@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"testing"
 
@@ -12,9 +11,12 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 )
 
 func TestCreateDomain(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -37,7 +39,7 @@ func TestCreateDomain(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -56,7 +58,7 @@ func TestCreateDomain(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -77,7 +79,7 @@ func TestCreateDomain(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -97,8 +99,14 @@ func TestCreateDomain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -111,6 +119,8 @@ func TestCreateDomain(t *testing.T) {
 }
 
 func TestGetAllDomains(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -135,7 +145,7 @@ func TestGetAllDomains(t *testing.T) {
 					return p.InitNewProject(
 						context.Background(),
 						model.InitNewProjectParams{
-							ProjectDirectory: t.TempDir(),
+							ProjectDirectory: ".",
 							ModuleName:       "my-project",
 						},
 					)
@@ -153,18 +163,17 @@ func TestGetAllDomains(t *testing.T) {
 			name: "empty list",
 			in: in{
 				preRun: func(p *Project) error {
-					dir := t.TempDir()
 					err := p.InitNewProject(
 						context.Background(),
 						model.InitNewProjectParams{
-							ProjectDirectory: dir,
+							ProjectDirectory: ".",
 							ModuleName:       "my-project",
 						},
 					)
 					if err != nil {
 						return err
 					}
-					return os.RemoveAll(filepath.Join(dir, "internal", "domain", "core"))
+					return p.fs.RemoveAll(filepath.Join("internal", "domain", "core"))
 				},
 			},
 			args: args{
@@ -179,11 +188,10 @@ func TestGetAllDomains(t *testing.T) {
 			name: "multi domain",
 			in: in{
 				preRun: func(p *Project) error {
-					dir := t.TempDir()
 					err := p.InitNewProject(
 						context.Background(),
 						model.InitNewProjectParams{
-							ProjectDirectory: dir,
+							ProjectDirectory: ".",
 							ModuleName:       "my-project",
 						},
 					)
@@ -214,8 +222,14 @@ func TestGetAllDomains(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -0,0 +1,188 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		run func(p *Project) error
+	}
+	type want struct {
+		created  []string
+		updated  []string
+		deleted  []string
+		contains map[string]string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "create domain",
+			args: args{
+				run: func(p *Project) error {
+					return p.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"})
+				},
+			},
+			want: want{},
+		},
+		{
+			name: "create service",
+			args: args{
+				run: func(p *Project) error {
+					_, err := p.CreateService(context.Background(), model.CreateServiceParams{
+						TargetDomain: "core",
+						StructName:   "Order",
+					})
+					return err
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("internal", "domain", "core", "service", "order", "order.go")},
+				contains: map[string]string{
+					filepath.Join("internal", "domain", "core", "service", "order", "order.go"): "type Order struct{}",
+				},
+			},
+		},
+		{
+			name: "create entry point",
+			args: args{
+				run: func(p *Project) error {
+					_, err := p.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{PackageName: "api"})
+					return err
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("cmd", "api", "main.go")},
+				contains: map[string]string{
+					filepath.Join("cmd", "api", "main.go"): "func main() {",
+				},
+			},
+		},
+		{
+			name: "move service",
+			args: args{
+				run: func(p *Project) error {
+					_, err := p.MoveComponent(context.Background(), model.MoveComponentParams{
+						Kind:    model.KindService,
+						Name:    "user",
+						NewName: "account",
+					})
+					return err
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("internal", "domain", "core", "service", "account", "account.go")},
+				deleted: []string{filepath.Join("internal", "domain", "core", "service", "user", "user.go")},
+				contains: map[string]string{
+					filepath.Join("internal", "domain", "core", "service", "account", "account.go"): "package account",
+				},
+			},
+		},
+		{
+			name: "init new project",
+			args: args{
+				run: func(p *Project) error {
+					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
+						ProjectDirectory: "other",
+						ModuleName:       "other-project",
+						CreateModule:     true,
+					})
+				},
+			},
+			want: want{
+				created: []string{
+					filepath.Join("other", ".gitignore"),
+					filepath.Join("other", ".hexago", "config.yaml"),
+					filepath.Join("other", "go.mod"),
+				},
+				contains: map[string]string{
+					filepath.Join("other", "go.mod"): "module other-project",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			fsys := filesystem.NewDryRun(filesystem.NewOS(root))
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   fsys,
+			}
+
+			err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			_, err = projectService.CreateService(context.Background(), model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "User",
+			})
+			require.NoError(t, err)
+
+			fsys.EnableDryRun()
+			require.NoError(t, tt.args.run(projectService))
+
+			changes, err := fsys.Changes()
+			require.NoError(t, err)
+
+			created := lo.FilterMap(changes, func(c filesystem.Change, _ int) (string, bool) {
+				return c.Path, c.IsCreated()
+			})
+			deleted := lo.FilterMap(changes, func(c filesystem.Change, _ int) (string, bool) {
+				return c.Path, c.IsDeleted()
+			})
+			updated := lo.FilterMap(changes, func(c filesystem.Change, _ int) (string, bool) {
+				return c.Path, !c.IsCreated() && !c.IsDeleted()
+			})
+
+			require.ElementsMatch(t, tt.want.created, created)
+			require.ElementsMatch(t, tt.want.deleted, deleted)
+			require.ElementsMatch(t, tt.want.updated, updated)
+
+			for _, name := range created {
+				require.NoFileExists(t, filepath.Join(root, name))
+			}
+			for _, name := range deleted {
+				require.FileExists(t, filepath.Join(root, name))
+			}
+
+			for _, change := range changes {
+				substr, ok := tt.want.contains[change.Path]
+				if !ok {
+					continue
+				}
+				require.Contains(t, string(change.New), substr)
+
+				diff, err := change.Diff()
+				require.NoError(t, err)
+				require.Contains(t, diff, "+++ b/"+filepath.ToSlash(change.Path))
+			}
+
+			require.NoDirExists(t, filepath.Join(root, "other"))
+		})
+	}
+}
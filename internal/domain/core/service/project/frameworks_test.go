@@ -0,0 +1,12 @@
+//go:build tools
+
+package project
+
+// The DI frameworks of the do, fx and wire templates are pinned in go.mod, so
+// the tests type check the generated code against their real APIs and run the
+// wire command. See requirePinnedModules.
+import (
+	_ "github.com/google/wire/cmd/wire"
+	_ "github.com/samber/do"
+	_ "go.uber.org/fx"
+)
@@ -2,10 +2,12 @@ package project
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
@@ -22,38 +24,54 @@ func (*Project) getTerminalName() (string, error) {
 	return strings.TrimSpace(shellPath), nil
 }
 
-func (*Project) createProjectDir(dirParam string) (string, error) {
-	projectPath, err := filepath.Abs(dirParam)
+// glob works like filepath.Glob on the file system of the project.
+func (p *Project) glob(pattern string) ([]string, error) {
+	matches, err := fs.Glob(p.fs, filepath.ToSlash(pattern))
 	if err != nil {
-		return "", fmt.Errorf("filepath: abs: %w", err)
+		return nil, fmt.Errorf("fs: glob: %w", err)
 	}
 
-	stat, err := os.Stat(projectPath)
+	for i := range matches {
+		matches[i] = filepath.FromSlash(matches[i])
+	}
+
+	return matches, nil
+}
+
+// createProjectDir creates the project directory if it does not exist and
+// returns its path relative to the root.
+func (p *Project) createProjectDir(dirParam string) (string, error) {
+	projectPath := filepath.Clean(dirParam)
+
+	if filepath.IsAbs(projectPath) {
+		relPath, err := filepath.Rel(p.root, projectPath)
+		if err != nil {
+			return "", fmt.Errorf("filepath: rel: %w", err)
+		}
+		projectPath = relPath
+	}
+
+	stat, err := p.fs.Stat(projectPath)
 	if !os.IsNotExist(err) && !stat.IsDir() {
 		return "", fmt.Errorf("stat: is dir: %w", customerrors.ErrDirMustBeFolder)
 	}
 
 	if os.IsNotExist(err) {
-		err = os.MkdirAll(projectPath, 0o755)
+		err = p.fs.MkdirAll(projectPath, 0o755)
 		if err != nil {
-			return "", fmt.Errorf("os: mkdir all: %w", err)
+			return "", fmt.Errorf("fs: mkdir all: %w", err)
 		}
 	}
 
-	err = os.Chdir(projectPath)
-	if err != nil {
-		return "", fmt.Errorf("os: chdir: %w", err)
-	}
-
 	return projectPath, nil
 }
 
-func (*Project) createHexagoConfigs(projectPath string) error {
+func (p *Project) createHexagoConfigs(projectPath string) error {
 	hexagoDir := filepath.Join(projectPath, ".hexago")
 
-	err := os.MkdirAll(hexagoDir, 0o755)
+	err := p.fs.MkdirAll(hexagoDir, 0o755)
 	if err != nil {
-		return fmt.Errorf("os: mkdir all: %w", err)
+		return fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
 	configPath := filepath.Join(hexagoDir, "config.yaml")
@@ -63,45 +81,46 @@ func (*Project) createHexagoConfigs(projectPath string) error {
 		return fmt.Errorf("assets: read file: %w", err)
 	}
 
-	err = os.WriteFile(configPath, configContent, 0o600)
+	err = p.fs.WriteFile(configPath, configContent, 0o600)
 	if err != nil {
-		return fmt.Errorf("os: write file: %w", err)
+		return fmt.Errorf("fs: write file: %w", err)
 	}
 
 	templatesPath := filepath.Join(hexagoDir, "templates")
-	err = os.MkdirAll(templatesPath, 0o755)
+	err = p.fs.MkdirAll(templatesPath, 0o755)
 	if err != nil {
-		return fmt.Errorf("os: mkdir all: %w", err)
+		return fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
 	return nil
 }
 
-func (*Project) addGitignore(projectPath string) error {
+func (p *Project) addGitignore(projectPath string) error {
 	configContent, err := assets.ReadFile("assets/.gitignore")
 	if err != nil {
 		return fmt.Errorf("assets: read file: %w", err)
 	}
 
-	err = os.WriteFile(filepath.Join(projectPath, ".gitignore"), configContent, 0o600)
+	err = p.fs.WriteFile(filepath.Join(projectPath, ".gitignore"), configContent, 0o600)
 	if err != nil {
-		return fmt.Errorf("os: write file: %w", err)
+		return fmt.Errorf("fs: write file: %w", err)
 	}
 
 	return nil
 }
 
-func (*Project) createProjectSubDirs() error {
+func (p *Project) createProjectSubDirs(projectPath string) error {
 	dirs := []string{
-		"cmd",
-		filepath.Join("internal", "domain", "core", "application"),
-		filepath.Join("internal", "domain", "core", "dto"),
-		filepath.Join("internal", "domain", "core", "model"),
-		filepath.Join("internal", "domain", "core", "service"),
-		filepath.Join("internal", "infrastructure"),
-		filepath.Join("internal", "pkg"),
-		filepath.Join("internal", "port"),
-		"pkg",
+		p.entryPointsDir(),
+		p.applicationsDir("core"),
+		filepath.Join(p.domainDir("core"), "dto"),
+		filepath.Join(p.domainDir("core"), "model"),
+		p.domainPortsDir("core"),
+		p.servicesDir("core"),
+		p.infrastructuresDir(),
+		p.packagesDir(false),
+		p.portsDir(),
+		p.packagesDir(true),
 		"config",
 		"schemas",
 		"scripts",
@@ -109,9 +128,9 @@ func (*Project) createProjectSubDirs() error {
 	}
 
 	for i := range dirs {
-		err := os.MkdirAll(dirs[i], 0o755)
+		err := p.fs.MkdirAll(filepath.Join(projectPath, dirs[i]), 0o755)
 		if err != nil {
-			return fmt.Errorf("os: mkdir all: %w", err)
+			return fmt.Errorf("fs: mkdir all: %w", err)
 		}
 	}
 
@@ -136,6 +155,15 @@ func (*Project) ValidatePkgName(pkgName string) error {
 	return nil
 }
 
+var fileNameRgx = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func (*Project) ValidateFileName(fileName string) error {
+	if !fileNameRgx.MatchString(fileName) {
+		return customerrors.ErrInvalidFileName
+	}
+	return nil
+}
+
 var pkgCmdRgx = regexp.MustCompile(`^[a-z][a-z0-9\-]*$`)
 
 func (*Project) ValidateEntryPointName(entryPointName string) error {
@@ -144,3 +172,18 @@ func (*Project) ValidateEntryPointName(entryPointName string) error {
 	}
 	return nil
 }
+
+var templateNameRgx = regexp.MustCompile(`^[a-z][a-z0-9\-]*$`)
+
+func (*Project) ValidateTemplateName(templateName string) error {
+	if !templateNameRgx.MatchString(templateName) {
+		return customerrors.ErrInvalidTemplateName
+	}
+	// built-in modes can not be overridden by custom templates
+	for _, tt := range templateTypes {
+		if slices.Contains(builtInTemplateModes(tt), templateName) {
+			return customerrors.ErrInvalidTemplateName
+		}
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -15,15 +14,15 @@ import (
 )
 
 func (p *Project) GetAllInfrastructures(_ context.Context) ([]string, error) {
-	infraPath := filepath.Join("internal", "infrastructure")
+	infraPath := p.infrastructuresDir()
 
-	infraCandidatePaths, err := filepath.Glob(filepath.Join(infraPath, "*"))
+	infraCandidatePaths, err := p.glob(filepath.Join(infraPath, "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	infraPaths := lo.Filter(infraCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -67,34 +66,31 @@ func (p *Project) CreateInfrastructure(ctx context.Context, params model.CreateI
 		return "", fmt.Errorf("is infra exist: %w", customerrors.ErrAlreadyExist)
 	}
 
-	infraDir := filepath.Join("internal", "infrastructure", params.PackageName)
+	infraDir := filepath.Join(p.infrastructuresDir(), params.PackageName)
 
-	err = os.MkdirAll(infraDir, 0o755)
+	err = p.fs.MkdirAll(infraDir, 0o755)
 	if err != nil {
-		return "", fmt.Errorf("os: mkdir all: %w", err)
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
-	infraFile, err := p.generateGoInitFile(
+	infraFile, err := p.generateComponentFiles(
 		ctx,
 		infraDir,
+		"",
 		params.StructName,
 		params.PackageName,
-		params.PortParam,
+		params.PortParams,
 		TTInfra,
 		params.AssertInterface,
+		nil,
 	)
 	if err != nil {
-		err2 := os.RemoveAll(infraDir)
+		err2 := p.fs.RemoveAll(infraDir)
 		if err2 != nil {
-			return "", fmt.Errorf("os: remove all: %w", err2)
+			return "", fmt.Errorf("fs: remove all: %w", err2)
 		}
 		return "", fmt.Errorf("generate infrastructure file: %w", err)
 	}
 
-	err = p.formatGoFiles(ctx, infraFile)
-	if err != nil {
-		return "", fmt.Errorf("format go files: %w", err)
-	}
-
 	return infraFile, nil
 }
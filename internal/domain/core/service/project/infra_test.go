@@ -11,9 +11,12 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 )
 
 func TestCreateInfrastructure(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -37,7 +40,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -48,7 +51,7 @@ func TestCreateInfrastructure(t *testing.T) {
 				params: model.CreateInfraParams{
 					StructName:      "MyInfra",
 					PackageName:     "myinfra",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -62,7 +65,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -73,7 +76,7 @@ func TestCreateInfrastructure(t *testing.T) {
 				params: model.CreateInfraParams{
 					StructName:      "MyInfra",
 					PackageName:     "myinfra",
-					PortParam:       "io.Writer",
+					PortParams:      []string{"io.Writer"},
 					AssertInterface: true,
 				},
 			},
@@ -87,7 +90,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -98,7 +101,7 @@ func TestCreateInfrastructure(t *testing.T) {
 				params: model.CreateInfraParams{
 					StructName:      "MyApp",
 					PackageName:     "myapp",
-					PortParam:       "notexisting",
+					PortParams:      []string{"notexisting"},
 					AssertInterface: true,
 				},
 			},
@@ -114,7 +117,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -147,7 +150,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -158,7 +161,7 @@ func TestCreateInfrastructure(t *testing.T) {
 				params: model.CreateInfraParams{
 					StructName:      "myApp",
 					PackageName:     "myapp",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -174,7 +177,7 @@ func TestCreateInfrastructure(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -185,7 +188,7 @@ func TestCreateInfrastructure(t *testing.T) {
 				params: model.CreateInfraParams{
 					StructName:      "MyApp",
 					PackageName:     "my-app",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -200,8 +203,14 @@ func TestCreateInfrastructure(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -213,6 +222,8 @@ func TestCreateInfrastructure(t *testing.T) {
 }
 
 func TestGetAllInfrastructures(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -235,7 +246,7 @@ func TestGetAllInfrastructures(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -269,7 +280,7 @@ func TestGetAllInfrastructures(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -287,8 +298,14 @@ func TestGetAllInfrastructures(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
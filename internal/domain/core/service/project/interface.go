@@ -0,0 +1,110 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"go/ast"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+// GetExternalInterfaces returns the exported interfaces of the standard
+// library and of the module dependencies, which can be implemented by the
+// components. Internal packages and the packages of the project are skipped,
+// since the ports of the project are returned by GetAllPorts.
+func (p *Project) GetExternalInterfaces(ctx context.Context) ([]model.Port, error) {
+	// only the file lists are loaded, the interfaces are found by parsing the
+	// files, which is much faster than type checking the whole build list
+	cfg, err := p.packagesConfig(ctx, packages.NeedName|packages.NeedFiles|packages.NeedModule)
+	if err != nil {
+		return nil, fmt.Errorf("packages config: %w", err)
+	}
+
+	pkgs, err := packages.Load(cfg, "std", "all")
+	if err != nil {
+		return nil, fmt.Errorf("packages: load: %w", err)
+	}
+
+	interfaces := make([]model.Port, 0)
+	seen := make(map[string]bool)
+
+	for _, pkg := range pkgs {
+		if seen[pkg.PkgPath] || !isExternalPackage(pkg) {
+			continue
+		}
+
+		seen[pkg.PkgPath] = true
+
+		for _, goFile := range pkg.GoFiles {
+			src, err2 := os.ReadFile(goFile)
+			if err2 != nil {
+				return nil, fmt.Errorf("os: read file: %w", err2)
+			}
+
+			// a file which can not be parsed is skipped, it must not prevent
+			// listing the interfaces of the other packages
+			ports, err2 := p.parseInterfacesSource(goFile, src)
+			if err2 != nil {
+				continue
+			}
+
+			for _, port := range ports {
+				if !isImplementable(port) {
+					continue
+				}
+
+				port.Path = ""
+				port.ImportPath = pkg.PkgPath
+
+				interfaces = append(interfaces, port)
+			}
+		}
+	}
+
+	slices.SortFunc(interfaces, func(a, b model.Port) int {
+		return cmp.Or(
+			cmp.Compare(a.ImportPath, b.ImportPath),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return interfaces, nil
+}
+
+// isExternalPackage reports whether the interfaces of the package can be
+// implemented out of the module which the package belongs to.
+func isExternalPackage(pkg *packages.Package) bool {
+	if pkg.Name == "" || pkg.Name == "main" || len(pkg.Errors) > 0 {
+		return false
+	}
+
+	if pkg.Module != nil && pkg.Module.Main {
+		return false
+	}
+
+	for _, elem := range strings.Split(pkg.PkgPath, "/") {
+		if elem == "internal" || elem == "vendor" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isImplementable reports whether the interface has something to implement
+// and all of its methods are exported.
+func isImplementable(port model.Port) bool {
+	if len(port.Methods) == 0 && len(port.Embeds) == 0 {
+		return false
+	}
+
+	return !slices.ContainsFunc(port.Methods, func(m model.PortMethod) bool {
+		return !ast.IsExported(m.Name)
+	})
+}
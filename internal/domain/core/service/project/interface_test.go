@@ -0,0 +1,57 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+func TestGetExternalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	projectService := newTemplateTestProject(t, "", map[string]string{
+		filepath.Join("internal", "pkg", "use", "u.go"): "package use\n\nimport _ \"example.com/dep\"\n",
+		filepath.Join("internal", "port", "store.go"):   "package port\n\ntype Store interface {\n\tGet() error\n}\n",
+	})
+
+	addLocalModule(t, projectService, "example.com/dep", map[string]string{
+		"dep.go":                               "package dep\n\ntype Notifier interface {\n\tNotify(msg string) error\n}\n",
+		filepath.Join("internal", "x", "x.go"): "package x\n\ntype Hidden interface {\n\tHide()\n}\n",
+	})
+
+	interfaces, err := projectService.GetExternalInterfaces(context.Background())
+	require.NoError(t, err)
+
+	names := lo.Map(interfaces, func(i model.Port, _ int) string {
+		return i.ImportPath + "." + i.Name
+	})
+
+	for _, name := range []string{
+		"io.Writer",
+		"io.ReadWriteCloser",
+		"net/http.Handler",
+		"example.com/dep.Notifier",
+	} {
+		require.Contains(t, names, name)
+	}
+
+	for _, name := range []string{
+		"cmp.Ordered",                       // constraint
+		"reflect.Type",                      // unexported methods
+		"example.com/dep/internal/x.Hidden", // internal package
+		"my-project/internal/port.Store",    // port of the project
+	} {
+		require.NotContains(t, names, name)
+	}
+
+	handler, ok := lo.Find(interfaces, func(i model.Port) bool {
+		return i.ImportPath == "net/http" && i.Name == "Handler"
+	})
+	require.True(t, ok)
+	require.Equal(t, "http", handler.Package)
+}
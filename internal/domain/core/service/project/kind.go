@@ -0,0 +1,206 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+var kindNameRgx = regexp.MustCompile(`^[a-z][a-z0-9\-]*$`)
+
+// reservedKindNames are the names of the built-in kinds, which can not be used
+// by the kinds in the config. The names of the commands are reserved when the
+// kind commands are registered.
+var reservedKindNames = []model.ComponentKind{
+	model.KindDomain, model.KindService, model.KindApplication, model.KindInfrastructure,
+	model.KindPackage, model.KindEntryPoint, model.KindPort,
+}
+
+// GetCustomKinds returns the component kinds declared in the config, sorted by
+// their names.
+func (p *Project) GetCustomKinds(_ context.Context) ([]model.CustomKind, error) {
+	kinds := p.cfg.GetKinds()
+
+	customKinds := make([]model.CustomKind, 0, len(kinds))
+
+	for _, name := range slices.Sorted(maps.Keys(kinds)) {
+		k := kinds[name]
+
+		switch {
+		case !kindNameRgx.MatchString(name):
+			return nil, customerrors.ErrInvalidKind{Kind: name, Reason: "name must be kebab-case"}
+		case slices.Contains(reservedKindNames, model.ComponentKind(name)):
+			return nil, customerrors.ErrInvalidKind{Kind: name, Reason: "name is reserved"}
+		case !filepath.IsLocal(k.Dir):
+			return nil, customerrors.ErrInvalidKind{Kind: name, Reason: "dir must be relative to the project root"}
+		case k.Suffix != "" && !instanceNameRgx.MatchString(k.Suffix):
+			return nil, customerrors.ErrInvalidKind{Kind: name, Reason: "suffix must be PascalCase"}
+		}
+
+		customKinds = append(customKinds, model.CustomKind{
+			Name:      name,
+			Dir:       k.Dir,
+			PerDomain: k.PerDomain,
+			Suffix:    k.Suffix,
+			Template:  k.Template,
+		})
+	}
+
+	return customKinds, nil
+}
+
+func (p *Project) getCustomKind(ctx context.Context, name string) (*model.CustomKind, error) {
+	kinds, err := p.GetCustomKinds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get custom kinds: %w", err)
+	}
+
+	k, ok := lo.Find(kinds, func(k model.CustomKind) bool {
+		return k.Name == name
+	})
+	if !ok {
+		return nil, customerrors.ErrInvalidKind{Kind: name, Reason: "not declared in the config"}
+	}
+
+	return &k, nil
+}
+
+// customKindDir returns the base directory of the kind. The domain is used
+// only for the kinds created in a domain.
+func (p *Project) customKindDir(k model.CustomKind, domain string) string {
+	if k.PerDomain {
+		return filepath.Join(p.domainDir(domain), k.Dir)
+	}
+	return k.Dir
+}
+
+func (p *Project) getCustomComponents(ctx context.Context, k model.CustomKind, targetDomain string) ([]model.Component, error) {
+	domains := []string{""}
+
+	if k.PerDomain {
+		domains = []string{targetDomain}
+
+		if targetDomain == "" || targetDomain == "*" {
+			var err error
+			domains, err = p.GetAllDomains(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("get all domains: %w", err)
+			}
+		} else {
+			err := p.isDomainExist(ctx, targetDomain)
+			if err != nil {
+				return nil, fmt.Errorf("is domain exist: %w", err)
+			}
+		}
+	}
+
+	components := make([]model.Component, 0)
+
+	for _, domain := range domains {
+		dir := p.customKindDir(k, domain)
+
+		candidatePaths, err := p.glob(filepath.Join(dir, "*"))
+		if err != nil {
+			return nil, fmt.Errorf("glob: %w", err)
+		}
+
+		for _, candidatePath := range candidatePaths {
+			stat, err2 := p.fs.Stat(candidatePath)
+			if err2 != nil || !stat.IsDir() {
+				continue
+			}
+
+			components = append(components, model.Component{
+				Kind:   model.ComponentKind(k.Name),
+				Name:   filepath.Base(candidatePath),
+				Domain: domain,
+				Path:   candidatePath,
+			})
+		}
+	}
+
+	return components, nil
+}
+
+// CreateCustomComponent creates a component of a kind in the config. The
+// suffix of the kind is appended to the struct name if it is missing, and
+// the package name defaults to the struct name without the suffix.
+func (p *Project) CreateCustomComponent(ctx context.Context, params model.CreateCustomComponentParams) (string, error) {
+	k, err := p.getCustomKind(ctx, params.Kind)
+	if err != nil {
+		return "", fmt.Errorf("get custom kind: %w", err)
+	}
+
+	err = p.ValidateInstanceName(params.StructName)
+	if err != nil {
+		return "", fmt.Errorf("validate instance name: %w", err)
+	}
+
+	structName := params.StructName
+	if !strings.HasSuffix(structName, k.Suffix) {
+		structName += k.Suffix
+	}
+
+	if params.PackageName == "" {
+		params.PackageName = strings.ToLower(strings.TrimSuffix(structName, k.Suffix))
+		if params.PackageName == "" {
+			params.PackageName = strings.ToLower(structName)
+		}
+	}
+
+	err = p.ValidatePkgName(params.PackageName)
+	if err != nil {
+		return "", fmt.Errorf("validate pkg name: %w", err)
+	}
+
+	targetDomain := ""
+	if k.PerDomain {
+		targetDomain = params.TargetDomain
+
+		err = p.isDomainExist(ctx, targetDomain)
+		if err != nil {
+			return "", fmt.Errorf("is domain exist: %w", err)
+		}
+	}
+
+	componentDir := filepath.Join(p.customKindDir(*k, targetDomain), params.PackageName)
+
+	if _, err = p.fs.Stat(componentDir); err == nil {
+		return "", fmt.Errorf("%s %s: %w", k.Name, params.PackageName, customerrors.ErrAlreadyExist)
+	}
+
+	err = p.fs.MkdirAll(componentDir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
+	}
+
+	componentFile, err := p.generateComponentFiles(
+		ctx,
+		componentDir,
+		targetDomain,
+		structName,
+		params.PackageName,
+		params.PortParams,
+		TemplateType(k.Name),
+		params.AssertInterface,
+		nil,
+	)
+	if err != nil {
+		err2 := p.fs.RemoveAll(componentDir)
+		if err2 != nil {
+			return "", fmt.Errorf("fs: remove all: %w", err2)
+		}
+		return "", fmt.Errorf("generate %s file: %w", k.Name, err)
+	}
+
+	return componentFile, nil
+}
@@ -0,0 +1,293 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+func TestGetCustomKinds(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		cfg string
+	}
+	type want struct {
+		err   require.ErrorAssertionFunc
+		kinds []model.CustomKind
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "defaults",
+			args: args{cfg: "kinds:\n  repository:\n    per_domain: true\n  consumer: {}\n"},
+			want: want{
+				err: require.NoError,
+				kinds: []model.CustomKind{
+					{Name: "consumer", Dir: filepath.Join("internal", "consumer"), Template: "std"},
+					{Name: "repository", Dir: "repository", PerDomain: true, Template: "std"},
+				},
+			},
+		},
+		{
+			name: "declared values",
+			args: args{cfg: "kinds:\n  event-handler:\n    dir: internal/adapters/events\n    suffix: Handler\n    template: do\n"},
+			want: want{
+				err: require.NoError,
+				kinds: []model.CustomKind{
+					{Name: "event-handler", Dir: filepath.Join("internal", "adapters", "events"), Suffix: "Handler", Template: "do"},
+				},
+			},
+		},
+		{
+			name: "no kinds",
+			args: args{cfg: ""},
+			want: want{
+				err:   require.NoError,
+				kinds: []model.CustomKind{},
+			},
+		},
+		{
+			name: "reserved name",
+			args: args{cfg: "kinds:\n  service: {}\n"},
+			want: want{err: require.Error},
+		},
+		{
+			name: "invalid name",
+			args: args{cfg: "kinds:\n  Repository: {}\n"},
+			want: want{err: require.Error},
+		},
+		{
+			name: "dir out of the project",
+			args: args{cfg: "kinds:\n  repository:\n    dir: ../repository\n"},
+			want: want{err: require.Error},
+		},
+		{
+			name: "invalid suffix",
+			args: args{cfg: "kinds:\n  repository:\n    suffix: repo\n"},
+			want: want{err: require.Error},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newTemplateTestProject(t, tt.args.cfg, nil)
+
+			kinds, err := projectService.GetCustomKinds(context.Background())
+			tt.want.err(t, err)
+			if err != nil {
+				require.ErrorAs(t, err, &customerrors.ErrInvalidKind{})
+				return
+			}
+
+			require.Equal(t, tt.want.kinds, kinds)
+		})
+	}
+}
+
+func TestCreateCustomComponent(t *testing.T) {
+	t.Parallel()
+
+	const cfg = `kinds:
+  repository:
+    per_domain: true
+    suffix: Repository
+  handler:
+    dir: internal/adapters/http
+    suffix: Handler
+    template: custom
+`
+
+	type args struct {
+		params model.CreateCustomComponentParams
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		file     string
+		contains []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "suffix is appended",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:         "repository",
+				TargetDomain: "core",
+				StructName:   "User",
+			}},
+			want: want{
+				err:      require.NoError,
+				file:     filepath.Join("internal", "domain", "core", "repository", "user", "user.go"),
+				contains: []string{"package user", "type UserRepository struct{}"},
+			},
+		},
+		{
+			name: "suffix is not repeated",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:         "repository",
+				TargetDomain: "core",
+				StructName:   "OrderRepository",
+			}},
+			want: want{
+				err:      require.NoError,
+				file:     filepath.Join("internal", "domain", "core", "repository", "order", "order.go"),
+				contains: []string{"type OrderRepository struct{}"},
+			},
+		},
+		{
+			name: "port is implemented",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:            "repository",
+				TargetDomain:    "core",
+				StructName:      "User",
+				PackageName:     "userrepo",
+				PortParams:      []string{"UserStore"},
+				AssertInterface: true,
+			}},
+			want: want{
+				err:  require.NoError,
+				file: filepath.Join("internal", "domain", "core", "repository", "userrepo", "userrepo.go"),
+				contains: []string{
+					`"my-project/internal/domain/core/port"`,
+					"var _ port.UserStore = (*UserRepository)(nil)",
+					"func (u *UserRepository) Get(id string) (string, error) {",
+				},
+			},
+		},
+		{
+			name: "custom template out of a domain",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:       "handler",
+				StructName: "Users",
+			}},
+			want: want{
+				err:      require.NoError,
+				file:     filepath.Join("internal", "adapters", "http", "users", "users.go"),
+				contains: []string{"// handler  my-project/internal/adapters/http/users", "type UsersHandler struct{}"},
+			},
+		},
+		{
+			name: "domain not found",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:         "repository",
+				TargetDomain: "billing",
+				StructName:   "User",
+			}},
+			want: want{err: require.Error},
+		},
+		{
+			name: "kind not declared",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:       "consumer",
+				StructName: "Order",
+			}},
+			want: want{err: require.Error},
+		},
+		{
+			name: "invalid name",
+			args: args{params: model.CreateCustomComponentParams{
+				Kind:       "handler",
+				StructName: "users",
+			}},
+			want: want{err: require.Error},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newTemplateTestProject(t, cfg, map[string]string{
+				filepath.Join(".hexago", "templates", "custom_handler.tmpl"): "package {{.PkgName}}\n\n// {{.Kind}} {{.Domain}} {{.PkgPath}}\n\ntype {{.StructName}} struct{}\n",
+			})
+
+			_, err := projectService.CreatePort(context.Background(), model.CreatePortParams{
+				TargetDomain: "core",
+				PortName:     "UserStore",
+				FileName:     "user",
+				Methods:      []string{"Get(id string) (string, error)"},
+			})
+			require.NoError(t, err)
+
+			file, err := projectService.CreateCustomComponent(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, tt.want.file, file)
+
+			content, err := projectService.fs.ReadFile(file)
+			require.NoError(t, err)
+
+			for _, substr := range tt.want.contains {
+				require.Contains(t, string(content), substr)
+			}
+
+			components, err := projectService.GetAllComponents(context.Background(), model.ComponentKind(tt.args.params.Kind), "*")
+			require.NoError(t, err)
+			require.Equal(t, []model.Component{{
+				Kind:   model.ComponentKind(tt.args.params.Kind),
+				Name:   filepath.Base(filepath.Dir(file)),
+				Domain: tt.args.params.TargetDomain,
+				Path:   filepath.Dir(file),
+			}}, components)
+
+			_, err = projectService.CreateCustomComponent(context.Background(), tt.args.params)
+			require.ErrorIs(t, err, customerrors.ErrAlreadyExist)
+		})
+	}
+}
+
+func TestCustomKindTemplates(t *testing.T) {
+	t.Parallel()
+
+	projectService := newTemplateTestProject(t, "kinds:\n  repository:\n    per_domain: true\n    template: do\n", nil)
+
+	templates, err := projectService.GetAllTemplates(context.Background(), "repository")
+	require.NoError(t, err)
+	require.Equal(t, []model.Template{
+		{Kind: "repository", Mode: "std", BuiltIn: true},
+		{Kind: "repository", Mode: "do", BuiltIn: true, Selected: true},
+		{Kind: "repository", Mode: "fx", BuiltIn: true},
+		{Kind: "repository", Mode: "wire", BuiltIn: true},
+	}, templates)
+
+	files, err := projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{
+		Kind:       "repository",
+		StructName: "User",
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "user.go", files[0].Path)
+	require.Contains(t, files[0].Content, `"github.com/samber/do"`)
+
+	templateDir, err := projectService.EjectTemplate(context.Background(), model.EjectTemplateParams{
+		Kind: "repository",
+		Mode: "std",
+		Name: "custom",
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(".hexago", "templates", "custom", "repository"), templateDir)
+
+	checks, err := projectService.CheckTemplates(context.Background())
+	require.NoError(t, err)
+	for _, check := range checks {
+		require.Empty(t, check.Error, check.Template.Kind+" "+check.Template.Mode)
+	}
+}
@@ -0,0 +1,47 @@
+package project
+
+import (
+	"path/filepath"
+)
+
+// The directories of the components are resolved from the layout in the
+// config, so projects with another naming convention can be managed.
+
+func (p *Project) entryPointsDir() string {
+	return p.cfg.GetLayout().EntryPoint
+}
+
+func (p *Project) domainsDir() string {
+	return p.cfg.GetLayout().Domain
+}
+
+func (p *Project) domainDir(domain string) string {
+	return filepath.Join(p.domainsDir(), domain)
+}
+
+func (p *Project) servicesDir(domain string) string {
+	return filepath.Join(p.domainDir(domain), p.cfg.GetLayout().Service)
+}
+
+func (p *Project) applicationsDir(domain string) string {
+	return filepath.Join(p.domainDir(domain), p.cfg.GetLayout().Application)
+}
+
+func (p *Project) domainPortsDir(domain string) string {
+	return filepath.Join(p.domainDir(domain), p.cfg.GetLayout().DomainPort)
+}
+
+func (p *Project) infrastructuresDir() string {
+	return p.cfg.GetLayout().Infrastructure
+}
+
+func (p *Project) packagesDir(global bool) string {
+	if global {
+		return p.cfg.GetLayout().GlobalPackage
+	}
+	return p.cfg.GetLayout().Package
+}
+
+func (p *Project) portsDir() string {
+	return p.cfg.GetLayout().Port
+}
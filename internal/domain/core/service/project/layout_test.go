@@ -0,0 +1,194 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func TestLayout(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		run func(p *Project) (string, error)
+	}
+	type want struct {
+		file     string
+		contains string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "service",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreateService(context.Background(), model.CreateServiceParams{
+						TargetDomain:    "billing",
+						StructName:      "Invoice",
+						PortParams:      []string{"InvoiceRepository"},
+						AssertInterface: true,
+					})
+				},
+			},
+			want: want{
+				file:     filepath.Join("internal", "core", "billing", "usecase", "invoice", "invoice.go"),
+				contains: `"my-project/internal/core/billing/ports"`,
+			},
+		},
+		{
+			name: "application",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreateApplication(context.Background(), model.CreateApplicationParams{
+						TargetDomain: "billing",
+						StructName:   "Checkout",
+					})
+				},
+			},
+			want: want{
+				file: filepath.Join("internal", "core", "billing", "application", "checkout", "checkout.go"),
+			},
+		},
+		{
+			name: "infrastructure",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreateInfrastructure(context.Background(), model.CreateInfraParams{
+						StructName:      "Postgres",
+						PortParams:      []string{"Clock"},
+						AssertInterface: true,
+					})
+				},
+			},
+			want: want{
+				file:     filepath.Join("internal", "adapters", "postgres", "postgres.go"),
+				contains: `"my-project/internal/ports"`,
+			},
+		},
+		{
+			name: "package",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreatePackage(context.Background(), model.CreatePackageParams{
+						StructName: "Slug",
+						IsGlobal:   true,
+					})
+				},
+			},
+			want: want{
+				file: filepath.Join("lib", "slug", "slug.go"),
+			},
+		},
+		{
+			name: "entry point",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{PackageName: "api"})
+				},
+			},
+			want: want{
+				file: filepath.Join("cmd", "api", "main.go"),
+			},
+		},
+		{
+			name: "port",
+			args: args{
+				run: func(p *Project) (string, error) {
+					return p.CreatePort(context.Background(), model.CreatePortParams{
+						PortName: "Mailer",
+						FileName: "mailer",
+						Methods:  []string{"Send(to string) error"},
+					})
+				},
+			},
+			want: want{
+				file:     filepath.Join("internal", "ports", "mailer.go"),
+				contains: "type Mailer interface",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			fsys := filesystem.NewOS(root)
+
+			cfg, err := config.New(filepath.Join(".hexago", "config.yaml"), fsys)
+			require.NoError(t, err)
+
+			projectService := &Project{
+				cfg:  cfg,
+				root: root,
+				fs:   fsys,
+			}
+
+			require.NoError(t, fsys.MkdirAll(".hexago", 0o755))
+			require.NoError(t, fsys.WriteFile(filepath.Join(".hexago", "config.yaml"), []byte(`layout:
+  domain: internal/core
+  service: usecase
+  domain_port: ports
+  infrastructure: internal/adapters
+  global_package: lib
+  port: internal/ports
+`), 0o644))
+			require.NoError(t, cfg.Load())
+
+			err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			require.DirExists(t, filepath.Join(root, "internal", "core", "core", "usecase"))
+			require.DirExists(t, filepath.Join(root, "internal", "adapters"))
+			require.NoDirExists(t, filepath.Join(root, "internal", "domain"))
+			require.NoDirExists(t, filepath.Join(root, "internal", "infrastructure"))
+
+			require.NoError(t, projectService.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"}))
+
+			_, err = projectService.CreatePort(context.Background(), model.CreatePortParams{
+				TargetDomain: "billing",
+				PortName:     "InvoiceRepository",
+				FileName:     "invoice",
+			})
+			require.NoError(t, err)
+			_, err = projectService.CreatePort(context.Background(), model.CreatePortParams{
+				PortName: "Clock",
+				FileName: "clock",
+			})
+			require.NoError(t, err)
+
+			file, err := tt.args.run(projectService)
+			require.NoError(t, err)
+			require.Equal(t, tt.want.file, file)
+
+			content, err := fsys.ReadFile(file)
+			require.NoError(t, err)
+			require.Contains(t, string(content), tt.want.contains)
+
+			domains, err := projectService.GetAllDomains(context.Background())
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"core", "billing"}, domains)
+
+			ports, err := projectService.GetAllPorts(context.Background(), "billing")
+			require.NoError(t, err)
+			require.Contains(t, lo.Map(ports, func(p model.Port, _ int) string {
+				return p.Path
+			}), filepath.Join("internal", "core", "billing", "ports", "invoice.go"))
+		})
+	}
+}
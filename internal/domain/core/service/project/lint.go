@@ -0,0 +1,248 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+// Lint parses the imports of every package in the module and reports the
+// imports that violate the layer rules declared in the config.
+func (p *Project) Lint(_ context.Context) ([]model.LintViolation, error) {
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	imports, err := p.collectModuleImports(moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("collect module imports: %w", err)
+	}
+
+	rules := p.cfg.GetLintRules()
+
+	violations := make([]model.LintViolation, 0)
+
+	for _, imp := range imports {
+		for _, rule := range rules {
+			if !lintRule(rule).violatedBy(imp.pkgDir, imp.targetDir) {
+				continue
+			}
+
+			violations = append(violations, model.LintViolation{
+				Rule:    rule.Name,
+				File:    imp.file,
+				Line:    imp.line,
+				Column:  imp.column,
+				Package: imp.pkgDir,
+				Import:  imp.importPath,
+			})
+		}
+	}
+
+	slices.SortFunc(violations, func(a, b model.LintViolation) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.Rule, b.Rule),
+		)
+	})
+
+	return violations, nil
+}
+
+type moduleImport struct {
+	file       string
+	line       int
+	column     int
+	pkgDir     string
+	importPath string
+	targetDir  string
+}
+
+// collectModuleImports returns the imports which point to a package inside
+// the given module.
+func (p *Project) collectModuleImports(moduleName string) ([]moduleImport, error) {
+	fset := token.NewFileSet()
+	imports := make([]moduleImport, 0)
+
+	err := fs.WalkDir(p.fs, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if filePath != "." && p.isIgnoredDir(d.Name()) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if path.Ext(filePath) != ".go" {
+			return nil
+		}
+
+		src, err := p.fs.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("fs: read file: %w", err)
+		}
+
+		filePath = filepath.FromSlash(filePath)
+
+		f, err := parser.ParseFile(fset, filePath, src, parser.ImportsOnly)
+		if err != nil {
+			return fmt.Errorf("parser: parse file: %w", err)
+		}
+
+		pkgDir := filepath.ToSlash(filepath.Dir(filePath))
+
+		for _, spec := range f.Imports {
+			importPath, err2 := strconv.Unquote(spec.Path.Value)
+			if err2 != nil {
+				return fmt.Errorf("strconv: unquote: %w", err2)
+			}
+
+			targetDir, ok := p.moduleRelativeDir(moduleName, importPath)
+			if !ok {
+				continue
+			}
+
+			pos := fset.Position(spec.Pos())
+
+			imports = append(imports, moduleImport{
+				file:       filePath,
+				line:       pos.Line,
+				column:     pos.Column,
+				pkgDir:     pkgDir,
+				importPath: importPath,
+				targetDir:  targetDir,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fs: walk dir: %w", err)
+	}
+
+	return imports, nil
+}
+
+func (*Project) isIgnoredDir(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata"
+}
+
+// moduleRelativeDir converts an import path into a slash separated directory
+// relative to the module root. It reports false for packages outside the module.
+func (*Project) moduleRelativeDir(moduleName, importPath string) (string, bool) {
+	if importPath == moduleName {
+		return ".", true
+	}
+
+	rel, ok := strings.CutPrefix(importPath, moduleName+"/")
+	if !ok {
+		return "", false
+	}
+
+	return rel, true
+}
+
+type lintRule config.LintRule
+
+// violatedBy reports whether an import from pkgDir to targetDir breaks the rule.
+// Variables like {domain} captured from the "from" pattern are reused in the
+// deny and allow patterns.
+func (r lintRule) violatedBy(pkgDir, targetDir string) bool {
+	captures := make(map[string]string)
+
+	if !matchLayerPattern(r.From, pkgDir, captures) {
+		return false
+	}
+
+	denied := slices.ContainsFunc(r.Deny, func(pattern string) bool {
+		return matchLayerPattern(pattern, targetDir, maps.Clone(captures))
+	})
+	if !denied {
+		return false
+	}
+
+	allowed := slices.ContainsFunc(r.Allow, func(pattern string) bool {
+		return matchLayerPattern(pattern, targetDir, maps.Clone(captures))
+	})
+
+	return !allowed
+}
+
+// matchLayerPattern matches a slash separated directory against a pattern.
+// "**" matches any number of segments, "{name}" captures a single segment and
+// any other segment is matched by path.Match.
+func matchLayerPattern(pattern, dir string, captures map[string]string) bool {
+	return matchSegments(splitSegments(pattern), splitSegments(dir), captures)
+}
+
+func splitSegments(s string) []string {
+	s = strings.Trim(path.Clean(filepath.ToSlash(s)), "/")
+	if s == "." || s == "" {
+		return nil
+	}
+	return strings.Split(s, "/")
+}
+
+func matchSegments(patterns, segments []string, captures map[string]string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+
+	if patterns[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(patterns[1:], segments[i:], captures) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if name, ok := captureName(patterns[0]); ok {
+		if captured, exists := captures[name]; exists {
+			return captured == segments[0] && matchSegments(patterns[1:], segments[1:], captures)
+		}
+
+		captures[name] = segments[0]
+		if matchSegments(patterns[1:], segments[1:], captures) {
+			return true
+		}
+		delete(captures, name)
+
+		return false
+	}
+
+	matched, err := path.Match(patterns[0], segments[0])
+	if err != nil || !matched {
+		return false
+	}
+
+	return matchSegments(patterns[1:], segments[1:], captures)
+}
+
+func captureName(segment string) (string, bool) {
+	if len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
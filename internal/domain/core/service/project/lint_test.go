@@ -0,0 +1,159 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		preRun func(p *Project) error
+	}
+	type want struct {
+		err        require.ErrorAssertionFunc
+		violations []model.LintViolation
+	}
+
+	initProject := func(p *Project) error {
+		err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
+			ProjectDirectory: ".",
+			ModuleName:       "my-project",
+			CreateModule:     true,
+		})
+		if err != nil {
+			return err
+		}
+		return p.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"})
+	}
+
+	writeFile := func(p *Project, name, content string) error {
+		err := p.fs.MkdirAll(filepath.Dir(name), 0o755)
+		if err != nil {
+			return err
+		}
+		return p.fs.WriteFile(name, []byte(content), 0o644)
+	}
+
+	tests := []struct {
+		name string
+		in
+		want
+	}{
+		{
+			name: "clean project",
+			in: in{
+				preRun: initProject,
+			},
+			want: want{
+				err:        require.NoError,
+				violations: []model.LintViolation{},
+			},
+		},
+		{
+			name: "service imports infrastructure",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = writeFile(p, "internal/infrastructure/db/db.go", "package db\n")
+					if err != nil {
+						return err
+					}
+					return writeFile(p, "internal/domain/core/service/user/user.go", "package user\n\nimport _ \"my-project/internal/infrastructure/db\"\n")
+				},
+			},
+			want: want{
+				err: require.NoError,
+				violations: []model.LintViolation{
+					{
+						Rule:    "service-dependencies",
+						File:    filepath.Join("internal", "domain", "core", "service", "user", "user.go"),
+						Line:    3,
+						Column:  8,
+						Package: "internal/domain/core/service/user",
+						Import:  "my-project/internal/infrastructure/db",
+					},
+				},
+			},
+		},
+		{
+			name: "cross domain import",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = writeFile(p, "internal/domain/billing/model/invoice.go", "package model\n")
+					if err != nil {
+						return err
+					}
+					return writeFile(p, "internal/domain/core/service/user/user.go", "package user\n\nimport _ \"my-project/internal/domain/billing/model\"\n")
+				},
+			},
+			want: want{
+				err: require.NoError,
+				violations: []model.LintViolation{
+					{
+						Rule:    "domain-isolation",
+						File:    filepath.Join("internal", "domain", "core", "service", "user", "user.go"),
+						Line:    3,
+						Column:  8,
+						Package: "internal/domain/core/service/user",
+						Import:  "my-project/internal/domain/billing/model",
+					},
+				},
+			},
+		},
+		{
+			name: "same domain import",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = writeFile(p, "internal/domain/core/model/user.go", "package model\n")
+					if err != nil {
+						return err
+					}
+					return writeFile(p, "internal/domain/core/service/user/user.go", "package user\n\nimport _ \"my-project/internal/domain/core/model\"\n")
+				},
+			},
+			want: want{
+				err:        require.NoError,
+				violations: []model.LintViolation{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
+			require.NoError(t, tt.in.preRun(projectService))
+
+			violations, err := projectService.Lint(context.Background())
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.violations, violations)
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package project
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+const (
+	MockStyleTestify = "testify"
+	MockStyleFunc    = "func"
+)
+
+const (
+	testifyModulePath     = "github.com/stretchr/testify"
+	testifyMockImportPath = testifyModulePath + "/mock"
+)
+
+// GenerateMocks generates a mock of the given port, or of every port if All is
+// set. Mocks of the ports in the port directory of the layout are written into
+// the configured mocks directory, and mocks of domain ports into a directory of
+// the same name next to the port directory of the domain.
+func (p *Project) GenerateMocks(ctx context.Context, params model.GenerateMocksParams) ([]model.GeneratedMock, error) {
+	style := params.Style
+	if style == "" {
+		style = p.cfg.GetMockStyle()
+	}
+
+	if style != MockStyleTestify && style != MockStyleFunc {
+		return nil, customerrors.ErrInvalidMockStyle{Style: style}
+	}
+
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	// testify mocks do not build unless the module requires testify
+	if style == MockStyleTestify {
+		ok, err2 := p.requiresModule(testifyModulePath)
+		if err2 != nil {
+			return nil, fmt.Errorf("requires module: %w", err2)
+		}
+		if !ok {
+			return nil, customerrors.ErrMissingRequirement{Module: testifyModulePath}
+		}
+	}
+
+	ports, err := p.mockTargets(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("mock targets: %w", err)
+	}
+
+	results := make([]model.GeneratedMock, 0, len(ports))
+
+	for _, port := range ports {
+		mockDir := p.cfg.GetMocksDir()
+		if port.Domain != "" {
+			mockDir = filepath.Join(filepath.Dir(port.Path), filepath.Base(mockDir))
+		}
+
+		filePath, err2 := p.generateMock(ctx, moduleName, mockDir, port, style)
+		if err2 != nil {
+			return nil, fmt.Errorf("generate mock: %w", err2)
+		}
+
+		results = append(results, model.GeneratedMock{
+			Port:   port.Name,
+			Domain: port.Domain,
+			Style:  style,
+			File:   filePath,
+		})
+	}
+
+	return results, nil
+}
+
+func (p *Project) mockTargets(ctx context.Context, params model.GenerateMocksParams) ([]model.Port, error) {
+	if params.All {
+		ports, err := p.GetAllPorts(ctx, params.TargetDomain)
+		if err != nil {
+			return nil, fmt.Errorf("get all ports: %w", err)
+		}
+		return ports, nil
+	}
+
+	port, err := p.findPort(ctx, params.PortName, params.TargetDomain)
+	if err != nil {
+		return nil, fmt.Errorf("find port: %w", err)
+	}
+
+	return []model.Port{*port}, nil
+}
+
+func (p *Project) generateMock(ctx context.Context, moduleName, mockDir string, port model.Port, style string) (string, error) {
+	pkg, err := p.loadPackage(ctx, port.ImportPath)
+	if err != nil {
+		return "", fmt.Errorf("load package: %w", err)
+	}
+
+	obj, ok := pkg.Types.Scope().Lookup(port.Name).(*types.TypeName)
+	if !ok {
+		return "", customerrors.ErrInterfaceNotFound{Name: port.Name}
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || !types.IsInterface(named) {
+		return "", customerrors.ErrInterfaceNotFound{Name: port.Name}
+	}
+
+	mockPkgName := filepath.Base(mockDir)
+	g := newStubGenerator(moduleName+"/"+filepath.ToSlash(mockDir), pkg)
+
+	methods := g.collectMethods(named.Underlying().(*types.Interface))
+
+	for _, m := range methods {
+		err = g.checkAccessible(m)
+		if err != nil {
+			return "", customerrors.ErrCanNotImplement{Interface: port.Name, Reason: err.Error()}
+		}
+	}
+
+	// the function fields of a func mock can not have the names of methods
+	if style == MockStyleFunc {
+		for _, m := range methods {
+			if slices.ContainsFunc(methods, func(other *types.Func) bool { return other.Name() == m.Name()+"Func" }) {
+				return "", customerrors.ErrCanNotImplement{
+					Interface: port.Name,
+					Reason:    fmt.Sprintf("field %sFunc of method %s collides with method %sFunc", m.Name(), m.Name(), m.Name()),
+				}
+			}
+		}
+	}
+
+	mg := &mockGenerator{
+		stubGenerator: g,
+		named:         named,
+		style:         style,
+	}
+
+	src := mg.generate(mockPkgName)
+
+	content, err := p.addImports(src, g.imports)
+	if err != nil {
+		return "", fmt.Errorf("add imports: %w", err)
+	}
+
+	filePath := filepath.Join(mockDir, toSnakeCase(port.Name)+".go")
+
+	// every package referred by the mock is known, so it is only formatted
+	content, err = format.Source(content)
+	if err != nil {
+		return "", fmt.Errorf("format: source: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	err = p.fs.MkdirAll(mockDir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
+	}
+
+	err = p.fs.WriteFile(filePath, content, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("fs: write file: %w", err)
+	}
+
+	return filePath, nil
+}
+
+type mockGenerator struct {
+	*stubGenerator
+	named *types.Named
+	style string
+}
+
+func (g *mockGenerator) generate(pkgName string) []byte {
+	buf := &bytes.Buffer{}
+
+	name := g.named.Obj().Name()
+	portType := g.qualifier(g.named.Obj().Pkg()) + "." + name
+
+	typeParams, typeArgs := g.typeParams()
+
+	fmt.Fprintf(buf, "// Code generated by hexago. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkgName)
+
+	if typeParams == "" {
+		fmt.Fprintf(buf, "var _ %s = (*%s)(nil)\n\n", portType, name)
+	}
+
+	methods := g.collectMethods(g.named.Underlying().(*types.Interface))
+
+	switch g.style {
+	case MockStyleTestify:
+		mockName := g.qualifier(types.NewPackage(testifyMockImportPath, "mock"))
+
+		fmt.Fprintf(buf, "// %s is a testify mock of %s.\n", name, portType)
+		fmt.Fprintf(buf, "type %s%s struct {\n\t%s.Mock\n}\n\n", name, typeParams, mockName)
+
+		for _, m := range methods {
+			g.writeTestifyMethod(buf, name+typeArgs, m)
+		}
+	case MockStyleFunc:
+		fmt.Fprintf(buf, "// %s is a fake of %s.\n", name, portType)
+		fmt.Fprintf(buf, "// Each method calls the function field of the same name with the Func suffix.\n")
+		fmt.Fprintf(buf, "type %s%s struct {\n", name, typeParams)
+		for _, m := range methods {
+			params, _ := g.params(m.Type().(*types.Signature), "f")
+			fmt.Fprintf(buf, "\t%sFunc func(%s)%s\n", m.Name(), params, g.results(m.Type().(*types.Signature)))
+		}
+		fmt.Fprintf(buf, "}\n\n")
+
+		for _, m := range methods {
+			g.writeFuncMethod(buf, name+typeArgs, m)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// typeParams returns the type parameter list of a generic port, like
+// "[K comparable, V any]", and the matching type argument list, like "[K, V]".
+func (g *mockGenerator) typeParams() (string, string) {
+	tparams := g.named.TypeParams()
+	if tparams.Len() == 0 {
+		return "", ""
+	}
+
+	params := make([]string, 0, tparams.Len())
+	args := make([]string, 0, tparams.Len())
+
+	for i := range tparams.Len() {
+		tparam := tparams.At(i)
+		params = append(params, tparam.Obj().Name()+" "+types.TypeString(tparam.Constraint(), g.qualifier))
+		args = append(args, tparam.Obj().Name())
+	}
+
+	return "[" + strings.Join(params, ", ") + "]", "[" + strings.Join(args, ", ") + "]"
+}
+
+// params returns the parameter list of the signature and the argument names.
+// Unnamed parameters and parameters colliding with the receiver or the local
+// variables of the generated method are renamed. Variadic arguments are
+// returned with the "..." suffix.
+func (g *mockGenerator) params(sig *types.Signature, recvName string) (string, []string) {
+	params := make([]string, 0, sig.Params().Len())
+	args := make([]string, 0, sig.Params().Len())
+
+	for i := range sig.Params().Len() {
+		param := sig.Params().At(i)
+
+		name := param.Name()
+		if name == "" || name == "_" || name == recvName || name == "ret" || strings.HasPrefix(name, "r") && isDigits(name[1:]) {
+			name = fmt.Sprintf("p%d", i)
+		}
+
+		typ := types.TypeString(param.Type(), g.qualifier)
+		arg := name
+
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + types.TypeString(param.Type().(*types.Slice).Elem(), g.qualifier)
+			arg += "..."
+		}
+
+		params = append(params, name+" "+typ)
+		args = append(args, arg)
+	}
+
+	return strings.Join(params, ", "), args
+}
+
+func (g *mockGenerator) results(sig *types.Signature) string {
+	switch sig.Results().Len() {
+	case 0:
+		return ""
+	case 1:
+		return " " + types.TypeString(sig.Results().At(0).Type(), g.qualifier)
+	}
+
+	results := make([]string, 0, sig.Results().Len())
+	for i := range sig.Results().Len() {
+		results = append(results, types.TypeString(sig.Results().At(i).Type(), g.qualifier))
+	}
+
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
+func (g *mockGenerator) writeDoc(buf *bytes.Buffer, m *types.Func) {
+	doc, ok := g.docs[m.Pos()]
+	if !ok {
+		return
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(doc, "\n"), "\n") {
+		fmt.Fprintf(buf, "// %s\n", line)
+	}
+}
+
+// writeTestifyMethod writes a method which records the call and returns the
+// values given to On(...).Return(...). Variadic arguments are recorded as a
+// single slice argument.
+func (g *mockGenerator) writeTestifyMethod(buf *bytes.Buffer, recvType string, m *types.Func) {
+	sig := m.Type().(*types.Signature)
+	params, args := g.params(sig, "m")
+
+	for i := range args {
+		args[i] = strings.TrimSuffix(args[i], "...")
+	}
+
+	g.writeDoc(buf, m)
+	fmt.Fprintf(buf, "func (m *%s) %s(%s)%s {\n", recvType, m.Name(), params, g.results(sig))
+
+	if sig.Results().Len() == 0 {
+		fmt.Fprintf(buf, "\tm.Called(%s)\n}\n\n", strings.Join(args, ", "))
+		return
+	}
+
+	fmt.Fprintf(buf, "\tret := m.Called(%s)\n\n", strings.Join(args, ", "))
+
+	errorType := types.Universe.Lookup("error").Type()
+	rets := make([]string, 0, sig.Results().Len())
+
+	for i := range sig.Results().Len() {
+		t := sig.Results().At(i).Type()
+
+		if types.Identical(t, errorType) {
+			rets = append(rets, fmt.Sprintf("ret.Error(%d)", i))
+			continue
+		}
+
+		fmt.Fprintf(buf, "\tvar r%d %s\n", i, types.TypeString(t, g.qualifier))
+		fmt.Fprintf(buf, "\tif v := ret.Get(%d); v != nil {\n\t\tr%d = v.(%s)\n\t}\n\n", i, i, types.TypeString(t, g.qualifier))
+		rets = append(rets, fmt.Sprintf("r%d", i))
+	}
+
+	fmt.Fprintf(buf, "\treturn %s\n}\n\n", strings.Join(rets, ", "))
+}
+
+// writeFuncMethod writes a method which delegates to the function field and
+// panics with a descriptive message if the field is not set.
+func (g *mockGenerator) writeFuncMethod(buf *bytes.Buffer, recvType string, m *types.Func) {
+	sig := m.Type().(*types.Signature)
+	params, args := g.params(sig, "f")
+
+	g.writeDoc(buf, m)
+	fmt.Fprintf(buf, "func (f *%s) %s(%s)%s {\n", recvType, m.Name(), params, g.results(sig))
+	fmt.Fprintf(buf, "\tif f.%sFunc == nil {\n", m.Name())
+	fmt.Fprintf(buf, "\t\tpanic(\"%s.%s: %sFunc is not set\")\n\t}\n\n", g.named.Obj().Name(), m.Name(), m.Name())
+
+	call := fmt.Sprintf("f.%sFunc(%s)", m.Name(), strings.Join(args, ", "))
+	if sig.Results().Len() == 0 {
+		fmt.Fprintf(buf, "\t%s\n}\n\n", call)
+		return
+	}
+
+	fmt.Fprintf(buf, "\treturn %s\n}\n\n", call)
+}
@@ -0,0 +1,224 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+const mockTestPorts = `package port
+
+import "context"
+
+type UserRepository interface {
+	// GetUser returns the user.
+	GetUser(ctx context.Context, id string) (string, error)
+	DeleteUser(context.Context, string) error
+	Log(format string, args ...any)
+}
+
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+}
+`
+
+func TestGenerateMocks(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		params   model.GenerateMocksParams
+		requires []string
+		ports    string
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		mocks    []model.GeneratedMock
+		contains map[string][]string
+	}
+
+	userRepositoryFile := filepath.Join("internal", "port", "mocks", "user_repository.go")
+	cacheFile := filepath.Join("internal", "port", "mocks", "cache.go")
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "testify style",
+			args: args{
+				params:   model.GenerateMocksParams{PortName: "UserRepository"},
+				requires: []string{"github.com/stretchr/testify v1.9.0"},
+			},
+			want: want{
+				err: require.NoError,
+				mocks: []model.GeneratedMock{
+					{Port: "UserRepository", Style: MockStyleTestify, File: userRepositoryFile},
+				},
+				contains: map[string][]string{
+					userRepositoryFile: {
+						"// Code generated by hexago. DO NOT EDIT.",
+						"package mocks",
+						`"github.com/stretchr/testify/mock"`,
+						"var _ port.UserRepository = (*UserRepository)(nil)",
+						"type UserRepository struct {\n\tmock.Mock\n}",
+						"// GetUser returns the user.\nfunc (m *UserRepository) GetUser(ctx context.Context, id string) (string, error) {",
+						"ret := m.Called(ctx, id)",
+						"return r0, ret.Error(1)",
+						"func (m *UserRepository) DeleteUser(p0 context.Context, p1 string) error {",
+						"func (m *UserRepository) Log(format string, args ...any) {\n\tm.Called(format, args)\n}",
+					},
+				},
+			},
+		},
+		{
+			name: "func style",
+			args: args{
+				params: model.GenerateMocksParams{PortName: "UserRepository", Style: MockStyleFunc},
+			},
+			want: want{
+				err: require.NoError,
+				mocks: []model.GeneratedMock{
+					{Port: "UserRepository", Style: MockStyleFunc, File: userRepositoryFile},
+				},
+				contains: map[string][]string{
+					userRepositoryFile: {
+						"GetUserFunc    func(ctx context.Context, id string) (string, error)",
+						"if f.GetUserFunc == nil {",
+						"return f.GetUserFunc(ctx, id)",
+						"f.LogFunc(format, args...)",
+					},
+				},
+			},
+		},
+		{
+			name: "all ports",
+			args: args{
+				params: model.GenerateMocksParams{All: true, Style: MockStyleFunc},
+			},
+			want: want{
+				err: require.NoError,
+				mocks: []model.GeneratedMock{
+					{Port: "UserRepository", Style: MockStyleFunc, File: userRepositoryFile},
+					{Port: "Cache", Style: MockStyleFunc, File: cacheFile},
+				},
+				contains: map[string][]string{
+					cacheFile: {
+						"type Cache[K comparable, V any] struct {",
+						"func (f *Cache[K, V]) Get(key K) (V, bool) {",
+					},
+				},
+			},
+		},
+		{
+			name: "testify is not required",
+			args: args{
+				params: model.GenerateMocksParams{PortName: "UserRepository", Style: MockStyleTestify},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					target := customerrors.ErrMissingRequirement{}
+					require.ErrorAs(t, err, &target)
+					require.Equal(t, "github.com/stretchr/testify", target.Module)
+				},
+				mocks: nil,
+			},
+		},
+		{
+			name: "func field collides with a method",
+			args: args{
+				params: model.GenerateMocksParams{PortName: "Router", Style: MockStyleFunc},
+				ports:  "package port\n\ntype Router interface {\n\tHandle(path string)\n\tHandleFunc(path string)\n}\n",
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrCanNotImplement{})
+				},
+				mocks: nil,
+			},
+		},
+		{
+			name: "invalid style",
+			args: args{
+				params: model.GenerateMocksParams{PortName: "UserRepository", Style: "gomock"},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrInvalidMockStyle{})
+				},
+				mocks: nil,
+			},
+		},
+		{
+			name: "port not found",
+			args: args{
+				params:   model.GenerateMocksParams{PortName: "Unknown"},
+				requires: []string{"github.com/stretchr/testify v1.9.0"},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrInterfaceNotFound{})
+				},
+				mocks: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
+
+			err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, projectService.fs.WriteFile(filepath.Join("internal", "port", "ports.go"), []byte(mockTestPorts), 0o644))
+
+			if tt.args.ports != "" {
+				require.NoError(t, projectService.fs.WriteFile(filepath.Join("internal", "port", "extra.go"), []byte(tt.args.ports), 0o644))
+			}
+
+			for _, r := range tt.args.requires {
+				goMod, err2 := projectService.fs.ReadFile("go.mod")
+				require.NoError(t, err2)
+				require.NoError(t, projectService.fs.WriteFile("go.mod", append(goMod, "\nrequire "+r+"\n"...), 0o644))
+			}
+
+			mocks, err := projectService.GenerateMocks(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.mocks, mocks)
+
+			for name, substrs := range tt.want.contains {
+				content, err := projectService.fs.ReadFile(name)
+				require.NoError(t, err)
+				for _, substr := range substrs {
+					require.Contains(t, string(content), substr)
+				}
+			}
+
+			// fakes do not depend on testify, so they must compile in the project
+			if tt.args.params.Style == MockStyleFunc && len(tt.want.mocks) > 0 {
+				_, err = projectService.loadPackage(context.Background(), "my-project/internal/port/mocks")
+				require.NoError(t, err)
+			}
+		})
+	}
+}
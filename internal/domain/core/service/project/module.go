@@ -4,75 +4,122 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 
+	"github.com/samber/lo"
 	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
 
 	"github.com/ksckaan1/hexago/internal/customerrors"
 )
 
-func (*Project) initGoModule(ctx context.Context, moduleName string) error {
-	_, err := os.Stat("go.mod")
+// initGoModule writes the go.mod file like `go mod init` does. The file is
+// written through the file system, so it is also created in dry-run mode.
+func (p *Project) initGoModule(ctx context.Context, projectPath, moduleName string) error {
+	modFilePath := filepath.Join(projectPath, "go.mod")
+
+	_, err := p.fs.Stat(modFilePath)
 	if !os.IsNotExist(err) {
-		err = os.Remove("go.mod")
+		err = p.fs.Remove(modFilePath)
 		if err != nil {
-			return fmt.Errorf("os: remove: %w", err)
+			return fmt.Errorf("fs: remove: %w", err)
 		}
 	}
 
 	if moduleName == "." {
-		abs, err2 := filepath.Abs(moduleName)
-		if err2 != nil {
-			return fmt.Errorf("filepath: abs: %w", err2)
-		}
+		moduleName = filepath.Base(filepath.Join(p.root, projectPath))
+	}
 
-		moduleName = filepath.Base(abs)
+	err = module.CheckImportPath(moduleName)
+	if err != nil {
+		return fmt.Errorf("module: check import path: %w", customerrors.ErrInitGoModule{Message: err.Error()})
 	}
 
-	cmd := exec.CommandContext(ctx, "go", "mod", "init", moduleName)
-	stdErr := &bytes.Buffer{}
-	cmd.Stderr = stdErr
+	goVersion, err := p.goVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("go version: %w", err)
+	}
+
+	modFile := &modfile.File{}
 
-	err = cmd.Run()
+	err = modFile.AddModuleStmt(moduleName)
 	if err != nil {
-		return fmt.Errorf("cmd: run: %w", customerrors.ErrInitGoModule{Message: strings.TrimSpace(stdErr.String())})
+		return fmt.Errorf("modfile: add module stmt: %w", customerrors.ErrInitGoModule{Message: err.Error()})
+	}
+
+	err = modFile.AddGoStmt(goVersion)
+	if err != nil {
+		return fmt.Errorf("modfile: add go stmt: %w", customerrors.ErrInitGoModule{Message: err.Error()})
+	}
+
+	content, err := modFile.Format()
+	if err != nil {
+		return fmt.Errorf("modfile: format: %w", err)
+	}
+
+	err = p.fs.WriteFile(modFilePath, content, 0o644)
+	if err != nil {
+		return fmt.Errorf("fs: write file: %w", err)
 	}
 
 	return nil
 }
 
+// goVersion returns the version of the installed go toolchain, like 1.23.4.
+func (*Project) goVersion(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "go", "env", "GOVERSION")
+	stdOut, stdErr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = stdOut, stdErr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("cmd: run: %w", customerrors.ErrInitGoModule{Message: strings.TrimSpace(stdErr.String())})
+	}
+
+	goVersion, ok := strings.CutPrefix(strings.TrimSpace(stdOut.String()), "go")
+	if !ok || !modfile.GoVersionRE.MatchString(goVersion) {
+		return "", customerrors.ErrInitGoModule{Message: fmt.Sprintf("unknown go version: %s", stdOut.String())}
+	}
+
+	return goVersion, nil
+}
+
 func (p *Project) GetModuleName(modulePath ...string) (string, error) {
 	mp := "go.mod"
 	if len(modulePath) > 0 {
 		mp = modulePath[0]
 	}
 
-	f, err := os.Open(mp)
+	content, err := p.fs.ReadFile(mp)
 	if err != nil {
 		return "", fmt.Errorf("module file not found: %s", mp)
 	}
-	defer func() {
-		err2 := f.Close()
-		if err2 != nil {
-			fmt.Println(err2)
-		}
-	}()
 
-	buf := &bytes.Buffer{}
+	modFile, err := modfile.Parse("go.mod", content, nil)
+	if err != nil {
+		return "", fmt.Errorf("modfile parse: %w", err)
+	}
+
+	return modFile.Module.Mod.Path, nil
+}
 
-	_, err = io.Copy(buf, f)
+// requiresModule reports whether the go.mod file of the project requires the
+// module of the given path.
+func (p *Project) requiresModule(modulePath string) (bool, error) {
+	content, err := p.fs.ReadFile("go.mod")
 	if err != nil {
-		return "", fmt.Errorf("io: copy: %w", err)
+		return false, fmt.Errorf("module file not found: %s", "go.mod")
 	}
 
-	modFile, err := modfile.Parse("go.mod", buf.Bytes(), nil)
+	modFile, err := modfile.Parse("go.mod", content, nil)
 	if err != nil {
-		return "", fmt.Errorf("modfile parse: %w", err)
+		return false, fmt.Errorf("modfile parse: %w", err)
 	}
 
-	return modFile.Module.Mod.Path, nil
+	return lo.ContainsBy(modFile.Require, func(r *modfile.Require) bool {
+		return r.Mod.Path == modulePath
+	}), nil
 }
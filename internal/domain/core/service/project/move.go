@@ -0,0 +1,579 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+// MoveComponent renames the component directory, moves services and
+// applications between domains, and renames the package clause and the struct
+// type of the component. Import paths and qualified references are rewritten
+// in every package of the module. If a step fails, the steps done before are
+// rolled back. Go files excluded by build constraints are not type-checked, so
+// the ones referring to the component are returned as skipped files.
+func (p *Project) MoveComponent(ctx context.Context, params model.MoveComponentParams) (*model.MoveResult, error) {
+	component, err := p.findComponent(ctx, model.RemoveComponentParams{
+		Kind:         params.Kind,
+		Name:         params.Name,
+		TargetDomain: params.TargetDomain,
+		IsGlobal:     params.IsGlobal,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find component: %w", err)
+	}
+
+	target, err := p.moveTarget(ctx, component, params)
+	if err != nil {
+		return nil, fmt.Errorf("move target: %w", err)
+	}
+
+	if target.Path == component.Path && params.NewStructName == "" {
+		return nil, errors.New("nothing to move: new name, domain or struct name must be given")
+	}
+
+	if target.Path != component.Path {
+		if _, err = p.fs.Stat(target.Path); err == nil {
+			return nil, fmt.Errorf("%s: %w", target.Path, customerrors.ErrAlreadyExist)
+		}
+	}
+
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	cfg, err := p.packagesConfig(ctx, packages.NeedName|packages.NeedFiles|packages.NeedImports|packages.NeedDeps|
+		packages.NeedTypes|packages.NeedSyntax|packages.NeedTypesInfo)
+	if err != nil {
+		return nil, fmt.Errorf("packages config: %w", err)
+	}
+	cfg.Tests = true
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("packages: load: %w", err)
+	}
+
+	// references in a package which can not be type-checked would be missed
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("packages: load: %s", pkg.Errors[0].Error())
+		}
+	}
+
+	r := &renamer{
+		root:    p.root,
+		fs:      p.fs,
+		oldPath: path.Join(moduleName, filepath.ToSlash(component.Path)),
+		newPath: path.Join(moduleName, filepath.ToSlash(target.Path)),
+		edits:   make(map[string][]textEdit),
+	}
+
+	if component.Kind != model.KindDomain && component.Kind != model.KindEntryPoint {
+		r.oldPkgName = p.packageName(pkgs, r.oldPath)
+		r.newPkgName = r.oldPkgName
+		if params.NewName != "" {
+			r.newPkgName = params.NewName
+		}
+	}
+
+	if params.NewStructName != "" {
+		if component.Kind == model.KindDomain || component.Kind == model.KindEntryPoint {
+			return nil, fmt.Errorf("%s has no struct to rename", component.Kind)
+		}
+
+		r.oldStructName, err = p.componentStructName(pkgs, r.oldPath, r.oldPkgName)
+		if err != nil {
+			return nil, fmt.Errorf("component struct name: %w", err)
+		}
+		r.newStructName = params.NewStructName
+	}
+
+	for _, pkg := range pkgs {
+		r.collectEdits(pkg)
+	}
+
+	// every edit is computed before anything is written, so that a failure
+	// leaves the project untouched
+	updates, err := r.updates()
+	if err != nil {
+		return nil, fmt.Errorf("updates: %w", err)
+	}
+
+	renameRunner := false
+	if component.Kind == model.KindEntryPoint && target.Name != component.Name {
+		if _, err2 := p.cfg.GetRunner(component.Name); err2 == nil {
+			update, err2 := p.runnerUpdate(component.Name, target.Name)
+			if err2 != nil {
+				return nil, fmt.Errorf("runner update: %w", err2)
+			}
+			updates = append(updates, *update)
+			renameRunner = true
+		}
+	}
+
+	skippedFiles, err := r.skippedFiles(pkgs)
+	if err != nil {
+		return nil, fmt.Errorf("skipped files: %w", err)
+	}
+
+	renames := make([]fileRename, 0, 2)
+
+	if r.oldPkgName != r.newPkgName {
+		oldFile := filepath.Join(component.Path, r.oldPkgName+".go")
+		newFile := filepath.Join(component.Path, r.newPkgName+".go")
+		if _, err = p.fs.Stat(oldFile); err == nil {
+			if _, err = p.fs.Stat(newFile); errors.Is(err, os.ErrNotExist) {
+				renames = append(renames, fileRename{from: oldFile, to: newFile})
+			}
+		}
+	}
+
+	if target.Path != component.Path {
+		renames = append(renames, fileRename{from: component.Path, to: target.Path})
+	}
+
+	err = p.applyMove(updates, renames)
+	if err != nil {
+		return nil, fmt.Errorf("apply move: %w", err)
+	}
+
+	if renameRunner {
+		err = p.cfg.Load()
+		if err != nil {
+			return nil, fmt.Errorf("cfg: load: %w", err)
+		}
+	}
+
+	movedPath := func(name string) string {
+		rel, ok := strings.CutPrefix(name, component.Path+string(filepath.Separator))
+		if !ok {
+			return name
+		}
+		if r.oldPkgName != r.newPkgName && rel == r.oldPkgName+".go" {
+			rel = r.newPkgName + ".go"
+		}
+		return filepath.Join(target.Path, rel)
+	}
+
+	updatedFiles := lo.Map(updates, func(u fileUpdate, _ int) string { return movedPath(u.path) })
+	skippedFiles = lo.Map(skippedFiles, func(name string, _ int) string { return movedPath(name) })
+
+	return &model.MoveResult{
+		From:         *component,
+		To:           *target,
+		StructName:   r.newStructName,
+		UpdatedFiles: updatedFiles,
+		SkippedFiles: skippedFiles,
+	}, nil
+}
+
+// moveTarget validates the new names and returns the component after the move.
+func (p *Project) moveTarget(ctx context.Context, component *model.Component, params model.MoveComponentParams) (*model.Component, error) {
+	target := *component
+
+	if params.NewName != "" {
+		var err error
+		if component.Kind == model.KindEntryPoint {
+			err = p.ValidateEntryPointName(params.NewName)
+		} else {
+			err = p.ValidatePkgName(params.NewName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validate new name: %w", err)
+		}
+
+		target.Name = params.NewName
+	}
+
+	if params.NewStructName != "" {
+		err := p.ValidateInstanceName(params.NewStructName)
+		if err != nil {
+			return nil, fmt.Errorf("validate instance name: %w", err)
+		}
+	}
+
+	if params.NewDomain != "" {
+		if component.Kind != model.KindService && component.Kind != model.KindApplication {
+			return nil, fmt.Errorf("%s can not be moved to a domain", component.Kind)
+		}
+
+		err := p.isDomainExist(ctx, params.NewDomain)
+		if err != nil {
+			return nil, fmt.Errorf("is domain exist: %w", err)
+		}
+
+		target.Domain = params.NewDomain
+	}
+
+	target.Path = filepath.Join(filepath.Dir(component.Path), target.Name)
+
+	if target.Domain != component.Domain {
+		kindDir := p.servicesDir(target.Domain)
+		if component.Kind == model.KindApplication {
+			kindDir = p.applicationsDir(target.Domain)
+		}
+		target.Path = filepath.Join(kindDir, target.Name)
+	}
+
+	return &target, nil
+}
+
+// runnerUpdate returns the update of the config file which renames the runner
+// of a moved entry point.
+func (p *Project) runnerUpdate(runner, newName string) (*fileUpdate, error) {
+	cfgPath := p.cfg.Location()
+	if rel, err := filepath.Rel(p.root, cfgPath); err == nil && filepath.IsAbs(cfgPath) && !strings.HasPrefix(rel, "..") {
+		cfgPath = rel
+	}
+
+	old, err := p.fs.ReadFile(cfgPath)
+	if err != nil {
+		return nil, fmt.Errorf("fs: read file: %w", err)
+	}
+
+	content, err := p.cfg.RenamedRunner(runner, newName)
+	if err != nil {
+		return nil, fmt.Errorf("cfg: renamed runner: %w", err)
+	}
+
+	if content == nil {
+		return nil, fmt.Errorf("runner %s not found in %s", runner, cfgPath)
+	}
+
+	return &fileUpdate{path: cfgPath, old: old, new: content}, nil
+}
+
+func (*Project) packageName(pkgs []*packages.Package, pkgPath string) string {
+	for _, pkg := range pkgs {
+		if pkg.PkgPath == pkgPath && pkg.Name != "" {
+			return pkg.Name
+		}
+	}
+	return path.Base(pkgPath)
+}
+
+// componentStructName returns the struct type of the component package. If the
+// package declares more than one exported struct, the one named after the
+// package is used.
+func (*Project) componentStructName(pkgs []*packages.Package, pkgPath, pkgName string) (string, error) {
+	for _, pkg := range pkgs {
+		if pkg.PkgPath != pkgPath || pkg.Types == nil {
+			continue
+		}
+
+		structs := make([]string, 0)
+
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !obj.Exported() || obj.IsAlias() {
+				continue
+			}
+			if _, ok = obj.Type().Underlying().(*types.Struct); ok {
+				structs = append(structs, name)
+			}
+		}
+
+		if len(structs) == 1 {
+			return structs[0], nil
+		}
+
+		for _, name := range structs {
+			if strings.EqualFold(name, pkgName) {
+				return name, nil
+			}
+		}
+
+		return "", fmt.Errorf("struct of package %s can not be determined: %s", pkgPath, strings.Join(structs, ", "))
+	}
+
+	return "", fmt.Errorf("package not found: %s", pkgPath)
+}
+
+type textEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+type renamer struct {
+	root          string
+	fs            port.FileSystem
+	oldPath       string
+	newPath       string
+	oldPkgName    string
+	newPkgName    string
+	oldStructName string
+	newStructName string
+	edits         map[string][]textEdit // file name -> edits
+}
+
+func (r *renamer) collectEdits(pkg *packages.Package) {
+	info := pkg.TypesInfo
+	if info == nil {
+		return
+	}
+
+	renamePkg := r.oldPkgName != r.newPkgName
+	renameStruct := r.oldStructName != "" && r.oldStructName != r.newStructName
+
+	for _, f := range pkg.Syntax {
+		fileName := pkg.Fset.Position(f.Pos()).Filename
+
+		add := func(node ast.Node, text string) {
+			r.edits[fileName] = append(r.edits[fileName], textEdit{
+				start: pkg.Fset.Position(node.Pos()).Offset,
+				end:   pkg.Fset.Position(node.End()).Offset,
+				text:  text,
+			})
+		}
+
+		// package clause of the moved package and its external test package
+		if renamePkg && strings.TrimSuffix(pkg.PkgPath, "_test") == r.oldPath {
+			switch f.Name.Name {
+			case r.oldPkgName:
+				add(f.Name, r.newPkgName)
+			case r.oldPkgName + "_test":
+				add(f.Name, r.newPkgName+"_test")
+			}
+		}
+
+		implicits := make(map[types.Object]bool)
+
+		for _, spec := range f.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || !isInDir(importPath, r.oldPath) {
+				continue
+			}
+
+			add(spec.Path, strconv.Quote(r.newPath+strings.TrimPrefix(importPath, r.oldPath)))
+
+			if obj, ok := info.Implicits[spec]; ok && importPath == r.oldPath {
+				implicits[obj] = true
+			}
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			ident, ok := n.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			obj := info.Uses[ident]
+			if obj == nil {
+				obj = info.Defs[ident]
+			}
+			if obj == nil {
+				return true
+			}
+
+			if renamePkg && implicits[obj] {
+				add(ident, r.newPkgName)
+			}
+
+			if renameStruct && r.isComponentStruct(obj) {
+				add(ident, r.newStructName)
+			}
+
+			return true
+		})
+	}
+}
+
+func (r *renamer) isComponentStruct(obj types.Object) bool {
+	typeName, ok := obj.(*types.TypeName)
+	if !ok || typeName.Pkg() == nil || typeName.Name() != r.oldStructName {
+		return false
+	}
+	return typeName.Pkg().Path() == r.oldPath && typeName.Parent() == typeName.Pkg().Scope()
+}
+
+type fileUpdate struct {
+	path string
+	old  []byte
+	new  []byte
+}
+
+type fileRename struct {
+	from string
+	to   string
+}
+
+// updates returns the new contents of the edited files without writing them.
+// The same file may be loaded by more than one package, like its test variant,
+// so duplicated edits are dropped.
+func (r *renamer) updates() ([]fileUpdate, error) {
+	updates := make([]fileUpdate, 0, len(r.edits))
+
+	for fileName, edits := range r.edits {
+		relPath, err := filepath.Rel(r.root, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("filepath: rel: %w", err)
+		}
+
+		// generated files of test binaries are outside of the module
+		if strings.HasPrefix(relPath, "..") {
+			continue
+		}
+
+		slices.SortFunc(edits, func(a, b textEdit) int {
+			return cmp.Compare(b.start, a.start)
+		})
+		edits = slices.CompactFunc(edits, func(a, b textEdit) bool {
+			return a.start == b.start
+		})
+
+		old, err2 := r.fs.ReadFile(relPath)
+		if err2 != nil {
+			return nil, fmt.Errorf("fs: read file: %w", err2)
+		}
+
+		content := slices.Clone(old)
+		for _, e := range edits {
+			content = slices.Concat(content[:e.start], []byte(e.text), content[e.end:])
+		}
+
+		content, err2 = format.Source(content)
+		if err2 != nil {
+			return nil, fmt.Errorf("format: source: %w", customerrors.ErrFormatGoFile{Message: err2.Error()})
+		}
+
+		updates = append(updates, fileUpdate{path: relPath, old: old, new: content})
+	}
+
+	slices.SortFunc(updates, func(a, b fileUpdate) int {
+		return cmp.Compare(a.path, b.path)
+	})
+
+	return updates, nil
+}
+
+// skippedFiles returns the files excluded by build constraints which import the
+// moved package, or which belong to it while its package clause or struct is
+// renamed. They are not loaded with type information, so they can not be
+// rewritten.
+func (r *renamer) skippedFiles(pkgs []*packages.Package) ([]string, error) {
+	renameInPkg := r.oldPkgName != r.newPkgName || (r.oldStructName != "" && r.oldStructName != r.newStructName)
+
+	skipped := make([]string, 0)
+
+	for _, pkg := range pkgs {
+		for _, fileName := range pkg.IgnoredFiles {
+			relPath, err := filepath.Rel(r.root, fileName)
+			if err != nil {
+				return nil, fmt.Errorf("filepath: rel: %w", err)
+			}
+
+			if slices.Contains(skipped, relPath) || filepath.Ext(relPath) != ".go" {
+				continue
+			}
+
+			if renameInPkg && strings.TrimSuffix(pkg.PkgPath, "_test") == r.oldPath {
+				skipped = append(skipped, relPath)
+				continue
+			}
+
+			content, err := r.fs.ReadFile(relPath)
+			if err != nil {
+				return nil, fmt.Errorf("fs: read file: %w", err)
+			}
+
+			f, err := parser.ParseFile(token.NewFileSet(), relPath, content, parser.ImportsOnly)
+			if err != nil {
+				// the go command reports the files it can not parse
+				continue
+			}
+
+			if slices.ContainsFunc(f.Imports, func(spec *ast.ImportSpec) bool {
+				importPath, err2 := strconv.Unquote(spec.Path.Value)
+				return err2 == nil && isInDir(importPath, r.oldPath)
+			}) {
+				skipped = append(skipped, relPath)
+			}
+		}
+	}
+
+	slices.Sort(skipped)
+
+	return skipped, nil
+}
+
+// applyMove writes the updated files and then renames the files and
+// directories in order. If a step fails, the steps done before are undone in
+// reverse order.
+func (p *Project) applyMove(updates []fileUpdate, renames []fileRename) (err error) {
+	undo := make([]func() error, 0, len(updates)+2*len(renames))
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, fn := range slices.Backward(undo) {
+			if err2 := fn(); err2 != nil {
+				err = errors.Join(err, fmt.Errorf("rollback: %w", err2))
+			}
+		}
+	}()
+
+	for _, u := range updates {
+		err = p.fs.WriteFile(u.path, u.new, 0o600)
+		if err != nil {
+			return fmt.Errorf("fs: write file: %w", err)
+		}
+		undo = append(undo, func() error {
+			return p.fs.WriteFile(u.path, u.old, 0o600)
+		})
+	}
+
+	for _, rn := range renames {
+		// the first missing parent is removed on rollback
+		created := ""
+		for dir := filepath.Dir(rn.to); dir != "." && dir != string(filepath.Separator); dir = filepath.Dir(dir) {
+			if _, err2 := p.fs.Stat(dir); err2 == nil {
+				break
+			}
+			created = dir
+		}
+
+		if created != "" {
+			err = p.fs.MkdirAll(filepath.Dir(rn.to), 0o755)
+			if err != nil {
+				return fmt.Errorf("fs: mkdir all: %w", err)
+			}
+			undo = append(undo, func() error {
+				return p.fs.RemoveAll(created)
+			})
+		}
+
+		err = p.fs.Rename(rn.from, rn.to)
+		if err != nil {
+			return fmt.Errorf("fs: rename: %w", err)
+		}
+		undo = append(undo, func() error {
+			return p.fs.Rename(rn.to, rn.from)
+		})
+	}
+
+	return nil
+}
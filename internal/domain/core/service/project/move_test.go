@@ -0,0 +1,333 @@
+package project
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+const moveTestImporter = `package main
+
+import (
+	"my-project/internal/domain/core/service/user"
+)
+
+func NewUser() (*user.User, error) {
+	return user.New()
+}
+`
+
+const moveTestTagged = `//go:build integration
+
+package main
+
+import (
+	"my-project/internal/domain/core/service/user"
+)
+
+var _ = user.New
+`
+
+// failingRenameFS fails to rename, so that the written files must be rolled
+// back.
+type failingRenameFS struct {
+	port.FileSystem
+}
+
+func (failingRenameFS) Rename(_, _ string) error {
+	return errors.New("rename failed")
+}
+
+func TestMoveComponent(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		params     model.MoveComponentParams
+		failRename bool
+		files      map[string]string
+		runners    string
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		from     string
+		to       string
+		contains map[string][]string
+		skipped  []string
+		runners  []string
+		compiled string
+	}
+
+	userPath := filepath.Join("internal", "domain", "core", "service", "user")
+	importerPath := filepath.Join("cmd", "api", "user.go")
+	taggedPath := filepath.Join("cmd", "api", "user_integration.go")
+	cfgPath := filepath.Join(".hexago", "config.yaml")
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "rename package and struct",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:          model.KindService,
+					Name:          "user",
+					NewName:       "account",
+					NewStructName: "Account",
+				},
+			},
+			want: want{
+				err:  require.NoError,
+				from: userPath,
+				to:   filepath.Join("internal", "domain", "core", "service", "account"),
+				contains: map[string][]string{
+					filepath.Join("internal", "domain", "core", "service", "account", "account.go"): {
+						"package account",
+						"type Account struct{}",
+						"func New() (*Account, error) {",
+					},
+					importerPath: {
+						`"my-project/internal/domain/core/service/account"`,
+						"func NewUser() (*account.Account, error) {",
+						"return account.New()",
+					},
+				},
+				skipped: []string{taggedPath},
+			},
+		},
+		{
+			name: "move to another domain",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:      model.KindService,
+					Name:      "user",
+					NewDomain: "billing",
+				},
+			},
+			want: want{
+				err:  require.NoError,
+				from: userPath,
+				to:   filepath.Join("internal", "domain", "billing", "service", "user"),
+				contains: map[string][]string{
+					importerPath: {
+						`"my-project/internal/domain/billing/service/user"`,
+						"return user.New()",
+					},
+				},
+				skipped: []string{taggedPath},
+			},
+		},
+		{
+			name: "rename entry point with runner",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:    model.KindEntryPoint,
+					Name:    "api",
+					NewName: "server",
+				},
+				runners: "  # the api runner\n  api:\n    env:\n      - PORT=8080\n  worker:\n    cmd: go run ./cmd/worker\n",
+			},
+			want: want{
+				err:  require.NoError,
+				from: filepath.Join("cmd", "api"),
+				to:   filepath.Join("cmd", "server"),
+				contains: map[string][]string{
+					cfgPath: {
+						"  # the api runner\n  server:\n    env:\n      - PORT=8080\n  worker:\n",
+					},
+				},
+				skipped:  []string{},
+				runners:  []string{"server", "worker"},
+				compiled: "my-project/cmd/server",
+			},
+		},
+		{
+			name: "package with errors",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:    model.KindService,
+					Name:    "user",
+					NewName: "account",
+				},
+				files: map[string]string{
+					filepath.Join("internal", "domain", "core", "service", "order", "broken.go"): "package order\n\nvar _ int = \"order\"\n",
+				},
+			},
+			want: want{
+				err:  require.Error,
+				from: userPath,
+			},
+		},
+		{
+			name: "rollback when the directory can not be renamed",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:      model.KindService,
+					Name:      "user",
+					NewDomain: "billing",
+				},
+				failRename: true,
+			},
+			want: want{
+				err:  require.Error,
+				from: userPath,
+			},
+		},
+		{
+			name: "invalid new name",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:    model.KindService,
+					Name:    "user",
+					NewName: "Account",
+				},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, customerrors.ErrInvalidPkgName)
+				},
+				from: userPath,
+			},
+		},
+		{
+			name: "invalid struct name",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:          model.KindService,
+					Name:          "user",
+					NewStructName: "account",
+				},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, customerrors.ErrInvalidInstanceName)
+				},
+				from: userPath,
+			},
+		},
+		{
+			name: "target already exists",
+			args: args{
+				params: model.MoveComponentParams{
+					Kind:    model.KindService,
+					Name:    "user",
+					NewName: "order",
+				},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, customerrors.ErrAlreadyExist)
+				},
+				from: userPath,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			fsys := filesystem.NewOS(root)
+
+			cfg, err := config.New(cfgPath, fsys)
+			require.NoError(t, err)
+
+			projectService := &Project{
+				cfg:  cfg,
+				root: root,
+				fs:   fsys,
+			}
+
+			err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, projectService.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"}))
+
+			for _, name := range []string{"User", "Order"} {
+				_, err = projectService.CreateService(context.Background(), model.CreateServiceParams{
+					TargetDomain: "core",
+					StructName:   name,
+				})
+				require.NoError(t, err)
+			}
+
+			_, err = projectService.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{PackageName: "api"})
+			require.NoError(t, err)
+			require.NoError(t, projectService.fs.WriteFile(importerPath, []byte(moveTestImporter), 0o644))
+			require.NoError(t, projectService.fs.WriteFile(taggedPath, []byte(moveTestTagged), 0o644))
+
+			for name, content := range tt.args.files {
+				require.NoError(t, projectService.fs.WriteFile(name, []byte(content), 0o644))
+			}
+
+			if tt.args.runners != "" {
+				content, err := projectService.fs.ReadFile(cfgPath)
+				require.NoError(t, err)
+				content = bytes.Replace(content, []byte("runners:\n"), []byte("runners:\n"+tt.args.runners), 1)
+				require.NoError(t, projectService.fs.WriteFile(cfgPath, content, 0o644))
+				require.NoError(t, cfg.Load())
+			}
+
+			if tt.args.failRename {
+				projectService.fs = failingRenameFS{FileSystem: projectService.fs}
+			}
+
+			result, err := projectService.MoveComponent(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+
+			if tt.want.to == "" {
+				require.Nil(t, result)
+				require.DirExists(t, filepath.Join(root, tt.want.from))
+
+				content, err := projectService.fs.ReadFile(importerPath)
+				require.NoError(t, err)
+				require.Equal(t, moveTestImporter, string(content))
+				return
+			}
+
+			require.Equal(t, tt.want.from, result.From.Path)
+			require.Equal(t, tt.want.to, result.To.Path)
+			require.NoDirExists(t, filepath.Join(root, tt.want.from))
+			require.DirExists(t, filepath.Join(root, tt.want.to))
+			require.Equal(t, tt.want.skipped, result.SkippedFiles)
+
+			for name, substrs := range tt.want.contains {
+				content, err := projectService.fs.ReadFile(name)
+				require.NoError(t, err)
+				for _, substr := range substrs {
+					require.Contains(t, string(content), substr)
+				}
+			}
+
+			for _, runner := range tt.want.runners {
+				_, err = cfg.GetRunner(runner)
+				require.NoError(t, err)
+			}
+			if len(tt.want.runners) > 0 {
+				_, err = cfg.GetRunner(tt.args.params.Name)
+				require.ErrorIs(t, err, customerrors.ErrRunnerNotImplemented)
+			}
+
+			// the module must still compile after the move
+			_, err = projectService.loadPackage(context.Background(), cmp.Or(tt.want.compiled, "my-project/cmd/api"))
+			require.NoError(t, err)
+		})
+	}
+}
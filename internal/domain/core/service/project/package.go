@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -15,19 +14,15 @@ import (
 )
 
 func (p *Project) GetAllPackages(_ context.Context, showGlobal bool) ([]string, error) {
-	pkgLocation := filepath.Join("internal", "pkg")
+	pkgLocation := p.packagesDir(showGlobal)
 
-	if showGlobal {
-		pkgLocation = "pkg"
-	}
-
-	pkgCandidatePaths, err := filepath.Glob(filepath.Join(pkgLocation, "*"))
+	pkgCandidatePaths, err := p.glob(filepath.Join(pkgLocation, "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	pkgPaths := lo.Filter(pkgCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -71,38 +66,31 @@ func (p *Project) CreatePackage(ctx context.Context, params model.CreatePackageP
 		return "", fmt.Errorf("is pkg exist: %w", customerrors.ErrAlreadyExist)
 	}
 
-	packageDir := filepath.Join("internal", "pkg", params.PackageName)
-
-	if params.IsGlobal {
-		packageDir = filepath.Join("pkg", params.PackageName)
-	}
+	packageDir := filepath.Join(p.packagesDir(params.IsGlobal), params.PackageName)
 
-	err = os.MkdirAll(packageDir, 0o755)
+	err = p.fs.MkdirAll(packageDir, 0o755)
 	if err != nil {
-		return "", fmt.Errorf("os: mkdir all: %w", err)
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
-	packageFile, err := p.generateGoInitFile(
+	packageFile, err := p.generateComponentFiles(
 		ctx,
 		packageDir,
+		"",
 		params.StructName,
 		params.PackageName,
-		params.PortParam,
+		params.PortParams,
 		TTPackage,
 		params.AssertInterface,
+		nil,
 	)
 	if err != nil {
-		err2 := os.RemoveAll(packageDir)
+		err2 := p.fs.RemoveAll(packageDir)
 		if err2 != nil {
-			return "", fmt.Errorf("os: remove all: %w", err2)
+			return "", fmt.Errorf("fs: remove all: %w", err2)
 		}
 		return "", fmt.Errorf("generate package file: %w", err)
 	}
 
-	err = p.formatGoFiles(ctx, packageFile)
-	if err != nil {
-		return "", fmt.Errorf("format go files: %w", err)
-	}
-
 	return packageFile, nil
 }
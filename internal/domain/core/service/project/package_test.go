@@ -11,9 +11,12 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 )
 
 func TestCreatePackage(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -37,7 +40,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -49,7 +52,7 @@ func TestCreatePackage(t *testing.T) {
 					StructName:      "MyPkg",
 					PackageName:     "mypkg",
 					IsGlobal:        false,
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -63,7 +66,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -75,7 +78,7 @@ func TestCreatePackage(t *testing.T) {
 					StructName:      "MyPkg",
 					PackageName:     "mypkg",
 					IsGlobal:        true,
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -89,7 +92,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -100,7 +103,7 @@ func TestCreatePackage(t *testing.T) {
 				params: model.CreatePackageParams{
 					StructName:      "MyPkg",
 					PackageName:     "mypkg",
-					PortParam:       "io.Writer",
+					PortParams:      []string{"io.Writer"},
 					AssertInterface: true,
 				},
 			},
@@ -114,7 +117,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -125,7 +128,7 @@ func TestCreatePackage(t *testing.T) {
 				params: model.CreatePackageParams{
 					StructName:      "MyPkg",
 					PackageName:     "mypkg",
-					PortParam:       "notexisting",
+					PortParams:      []string{"notexisting"},
 					AssertInterface: true,
 				},
 			},
@@ -141,7 +144,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -174,7 +177,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -185,7 +188,7 @@ func TestCreatePackage(t *testing.T) {
 				params: model.CreatePackageParams{
 					StructName:      "myPkg",
 					PackageName:     "mypkg",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -201,7 +204,7 @@ func TestCreatePackage(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -212,7 +215,7 @@ func TestCreatePackage(t *testing.T) {
 				params: model.CreatePackageParams{
 					StructName:      "MyPkg",
 					PackageName:     "my-pkg",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -227,8 +230,14 @@ func TestCreatePackage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -240,6 +249,8 @@ func TestCreatePackage(t *testing.T) {
 }
 
 func TestGetAllPackages(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -262,7 +273,7 @@ func TestGetAllPackages(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -296,7 +307,7 @@ func TestGetAllPackages(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -314,8 +325,14 @@ func TestGetAllPackages(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
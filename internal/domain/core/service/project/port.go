@@ -1,28 +1,538 @@
 package project
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"maps"
+	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
 )
 
-func (p *Project) GetAllPorts(_ context.Context) ([]string, error) {
-	portsPath := filepath.Join("internal", "port")
+// GetAllPorts returns the ports declared in the port directory of the layout
+// and in the port directories of the domains. An empty or "*" target domain
+// means all domains, otherwise only the ports visible to the target domain are
+// returned.
+func (p *Project) GetAllPorts(ctx context.Context, targetDomain string) ([]model.Port, error) {
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	var domains []string
+
+	if targetDomain == "" || targetDomain == "*" {
+		domains, err = p.GetAllDomains(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get all domains: %w", err)
+		}
+	} else {
+		err = p.isDomainExist(ctx, targetDomain)
+		if err != nil {
+			return nil, fmt.Errorf("is domain exist: %w", err)
+		}
+		domains = []string{targetDomain}
+	}
+
+	allPorts, err := p.getPortsInDir(moduleName, p.portsDir(), "")
+	if err != nil {
+		return nil, fmt.Errorf("get ports in dir: %w", err)
+	}
+
+	for _, domain := range domains {
+		ports, err2 := p.getPortsInDir(moduleName, p.domainPortsDir(domain), domain)
+		if err2 != nil {
+			return nil, fmt.Errorf("get ports in dir: %w", err2)
+		}
 
-	portFilePaths, err := filepath.Glob(filepath.Join(portsPath, "*.go"))
+		allPorts = append(allPorts, ports...)
+	}
+
+	return allPorts, nil
+}
+
+func (p *Project) getPortsInDir(moduleName, portsPath, domain string) ([]model.Port, error) {
+	portFilePaths, err := p.glob(filepath.Join(portsPath, "*.go"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
-	allPorts := make([]string, 0)
+	allPorts := make([]model.Port, 0)
 	for i := range portFilePaths {
+		if strings.HasSuffix(portFilePaths[i], "_test.go") {
+			continue
+		}
+
 		ports, err2 := p.parseInterfaces(portFilePaths[i])
 		if err2 != nil {
 			return nil, fmt.Errorf("parse interfaces: %w", err2)
 		}
 
+		for j := range ports {
+			ports[j].Domain = domain
+			ports[j].ImportPath = path.Join(moduleName, filepath.ToSlash(portsPath))
+		}
+
 		allPorts = append(allPorts, ports...)
 	}
 
 	return allPorts, nil
 }
+
+// findPort resolves a plain port name. A port of the target domain has
+// priority over a port in the port directory of the layout. Ports of other
+// domains are used only if the name is not ambiguous.
+func (p *Project) findPort(ctx context.Context, portName, targetDomain string) (*model.Port, error) {
+	allPorts, err := p.GetAllPorts(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all ports: %w", err)
+	}
+
+	candidates := lo.Filter(allPorts, func(port model.Port, _ int) bool {
+		return port.Name == portName
+	})
+
+	if port, ok := lo.Find(candidates, func(port model.Port) bool {
+		return targetDomain != "" && port.Domain == targetDomain
+	}); ok {
+		return &port, nil
+	}
+
+	if port, ok := lo.Find(candidates, func(port model.Port) bool {
+		return port.Domain == ""
+	}); ok {
+		return &port, nil
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, customerrors.ErrInterfaceNotFound{Name: portName}
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, customerrors.ErrAmbiguousPort{
+			PortName: portName,
+			Domains: lo.Map(candidates, func(port model.Port, _ int) string {
+				return port.Domain
+			}),
+		}
+	}
+}
+
+// parseInterfaces returns the exported interfaces declared in the file.
+// Constraint interfaces which contain type terms are skipped, since they can
+// not be implemented.
+func (p *Project) parseInterfaces(interfaceFile string) ([]model.Port, error) {
+	src, err := p.fs.ReadFile(interfaceFile)
+	if err != nil {
+		return nil, fmt.Errorf("fs: read file: %w", err)
+	}
+
+	return p.parseInterfacesSource(interfaceFile, src)
+}
+
+// parseInterfacesSource returns the exported interfaces declared in the source
+// which can be implemented, so constraint interfaces are skipped.
+func (p *Project) parseInterfacesSource(interfaceFile string, src []byte) ([]model.Port, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, interfaceFile, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("parser: parse file: %w", err)
+	}
+
+	ports := make([]model.Port, 0)
+
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+
+			ifaceType, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || !typeSpec.Name.IsExported() {
+				continue
+			}
+
+			port, ok := p.parsePort(fset, ifaceType)
+			if !ok {
+				continue
+			}
+
+			port.Name = typeSpec.Name.Name
+			port.Package = f.Name.Name
+			port.Path = interfaceFile
+
+			doc := typeSpec.Doc
+			if doc == nil && !genDecl.Lparen.IsValid() {
+				doc = genDecl.Doc
+			}
+			port.Doc = strings.TrimSpace(doc.Text())
+
+			if typeSpec.TypeParams != nil {
+				port.TypeParams = p.typeParamsString(fset, typeSpec.TypeParams)
+			}
+
+			ports = append(ports, port)
+		}
+	}
+
+	return ports, nil
+}
+
+func (p *Project) parsePort(fset *token.FileSet, ifaceType *ast.InterfaceType) (model.Port, bool) {
+	port := model.Port{
+		Component: model.Component{Kind: model.KindPort},
+		Methods:   make([]model.PortMethod, 0),
+	}
+
+	for _, field := range ifaceType.Methods.List {
+		if len(field.Names) == 0 {
+			switch field.Type.(type) {
+			case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+				port.Embeds = append(port.Embeds, p.nodeString(fset, field.Type))
+				continue
+			default: // type terms like ~int | string
+				return model.Port{}, false
+			}
+		}
+
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			continue
+		}
+
+		signature := strings.TrimPrefix(p.nodeString(fset, funcType), "func")
+
+		for _, name := range field.Names {
+			port.Methods = append(port.Methods, model.PortMethod{
+				Name:      name.Name,
+				Signature: signature,
+				Doc:       strings.TrimSpace(field.Doc.Text()),
+			})
+		}
+	}
+
+	return port, true
+}
+
+// typeParamsString formats a type parameter list like "[K comparable, V any]".
+func (p *Project) typeParamsString(fset *token.FileSet, typeParams *ast.FieldList) string {
+	params := make([]string, 0, len(typeParams.List))
+
+	for _, field := range typeParams.List {
+		names := make([]string, 0, len(field.Names))
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		params = append(params, strings.Join(names, ", ")+" "+p.nodeString(fset, field.Type))
+	}
+
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+func (*Project) nodeString(fset *token.FileSet, node any) string {
+	buf := &bytes.Buffer{}
+	_ = printer.Fprint(buf, fset, node)
+	return buf.String()
+}
+
+// CreatePort creates an interface in "<port dir>/<file>.go" or in the port
+// directory of the target domain. If the file exists, the interface is
+// appended to it. Missing imports of the method signatures are resolved.
+func (p *Project) CreatePort(ctx context.Context, params model.CreatePortParams) (string, error) {
+	err := p.ValidateInstanceName(params.PortName)
+	if err != nil {
+		return "", fmt.Errorf("validate instance name: %w", err)
+	}
+
+	if params.FileName == "" {
+		params.FileName = strings.ToLower(params.PortName)
+	}
+
+	params.FileName = strings.TrimSuffix(params.FileName, ".go")
+
+	err = p.ValidateFileName(params.FileName)
+	if err != nil {
+		return "", fmt.Errorf("validate file name: %w", err)
+	}
+
+	methodNames := make(map[string]bool, len(params.Methods))
+
+	for _, method := range params.Methods {
+		name, err2 := p.parseMethodSignature(method)
+		if err2 != nil {
+			return "", fmt.Errorf("parse method signature: %w", err2)
+		}
+
+		if methodNames[name] {
+			return "", fmt.Errorf("parse method signature: %w", customerrors.ErrInvalidMethodSignature{
+				Signature: method,
+				Message:   "duplicate method " + name,
+			})
+		}
+
+		methodNames[name] = true
+	}
+
+	portDir := p.portsDir()
+
+	if params.TargetDomain != "" {
+		err = p.isDomainExist(ctx, params.TargetDomain)
+		if err != nil {
+			return "", fmt.Errorf("is domain exist: %w", err)
+		}
+
+		portDir = p.domainPortsDir(params.TargetDomain)
+	}
+
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return "", fmt.Errorf("get module name: %w", err)
+	}
+
+	existingPorts, err := p.getPortsInDir(moduleName, portDir, params.TargetDomain)
+	if err != nil {
+		return "", fmt.Errorf("get ports in dir: %w", err)
+	}
+
+	if slices.ContainsFunc(existingPorts, func(port model.Port) bool {
+		return port.Name == params.PortName
+	}) {
+		return "", fmt.Errorf("is port exist: %w", customerrors.ErrAlreadyExist)
+	}
+
+	err = p.fs.MkdirAll(portDir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
+	}
+
+	portFile := filepath.Join(portDir, params.FileName+".go")
+
+	src, err := p.fs.ReadFile(portFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("fs: read file: %w", err)
+	}
+
+	buf := bytes.NewBuffer(src)
+
+	if len(src) == 0 {
+		pkgName, err2 := p.dirPackageName(portDir)
+		if err2 != nil {
+			return "", fmt.Errorf("dir package name: %w", err2)
+		}
+
+		fmt.Fprintf(buf, "package %s\n", pkgName)
+	}
+
+	fmt.Fprintf(buf, "\ntype %s interface {\n", params.PortName)
+	for _, method := range params.Methods {
+		fmt.Fprintf(buf, "\t%s\n", strings.TrimSpace(method))
+	}
+	buf.WriteString("}\n")
+
+	// goimports reads the project from the disk, so the packages of the
+	// project are resolved through the file system first
+	projectImports, err := p.projectImports(ctx, portDir)
+	if err != nil {
+		return "", fmt.Errorf("project imports: %w", err)
+	}
+
+	content, err := p.addImports(buf.Bytes(), projectImports)
+	if err != nil {
+		return "", fmt.Errorf("add imports: %w", err)
+	}
+
+	// the remaining packages, like the standard library, are resolved by
+	// goimports. The path must not be relative to the working directory of
+	// the process.
+	content, err = imports.Process(filepath.Join(p.root, portFile), content, &imports.Options{
+		Comments:  true,
+		TabIndent: true,
+		TabWidth:  8,
+	})
+	if err != nil {
+		return "", fmt.Errorf("imports: process: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	err = p.fs.WriteFile(portFile, content, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("fs: write file: %w", err)
+	}
+
+	return portFile, nil
+}
+
+// projectImports returns the packages which a file in dir can refer to by
+// name: the packages of the project and the imports of the other files in
+// dir, which take precedence. Names which are ambiguous are left out. The
+// result maps import paths to package names.
+func (p *Project) projectImports(ctx context.Context, dir string) (map[string]string, error) {
+	var pkgs []*packages.Package
+
+	// the imports of the other files are still used if the packages can not
+	// be loaded from the file system
+	cfg, err := p.packagesConfig(ctx, packages.NeedName)
+	if _, ok := lo.ErrorsAs[customerrors.ErrPackagesNotLoadable](err); !ok {
+		if err != nil {
+			return nil, fmt.Errorf("packages config: %w", err)
+		}
+
+		pkgs, err = packages.Load(cfg, "./...")
+		if err != nil {
+			return nil, fmt.Errorf("packages: load: %w", err)
+		}
+	}
+
+	pkgNames := make(map[string]string) // import path -> package name
+	for _, pkg := range pkgs {
+		if pkg.Name != "" {
+			pkgNames[pkg.PkgPath] = pkg.Name
+		}
+	}
+
+	byName := make(map[string][]string) // package name -> import paths
+	for importPath, name := range pkgNames {
+		byName[name] = append(byName[name], importPath)
+	}
+
+	goFiles, err := p.glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("glob: %w", err)
+	}
+
+	siblings := make(map[string][]string) // package name -> import paths
+	for _, goFile := range goFiles {
+		src, err2 := p.fs.ReadFile(goFile)
+		if err2 != nil {
+			return nil, fmt.Errorf("fs: read file: %w", err2)
+		}
+
+		// a file which can not be parsed is skipped like in GetAllPorts
+		f, err2 := parser.ParseFile(token.NewFileSet(), goFile, src, parser.ImportsOnly)
+		if err2 != nil {
+			continue
+		}
+
+		for _, spec := range f.Imports {
+			importPath, err3 := strconv.Unquote(spec.Path.Value)
+			if err3 != nil {
+				continue
+			}
+
+			name := cmp.Or(pkgNames[importPath], path.Base(importPath))
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			if !token.IsIdentifier(name) || slices.Contains(siblings[name], importPath) {
+				continue
+			}
+
+			siblings[name] = append(siblings[name], importPath)
+		}
+	}
+
+	result := make(map[string]string, len(byName))
+	for name, importPaths := range byName {
+		if len(importPaths) == 1 && siblings[name] == nil {
+			result[importPaths[0]] = name
+		}
+	}
+
+	// an import of the other files may refer to a package of the project
+	// with another name
+	for _, name := range slices.Sorted(maps.Keys(siblings)) {
+		if len(siblings[name]) == 1 {
+			result[siblings[name][0]] = name
+		}
+	}
+
+	return result, nil
+}
+
+// dirPackageName returns the package name of the go files in the directory.
+// If there is none, the name of the directory is used, or port if the
+// directory name is not a valid package name.
+func (p *Project) dirPackageName(dir string) (string, error) {
+	goFiles, err := p.glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", fmt.Errorf("glob: %w", err)
+	}
+
+	for _, goFile := range goFiles {
+		if strings.HasSuffix(goFile, "_test.go") {
+			continue
+		}
+
+		src, err2 := p.fs.ReadFile(goFile)
+		if err2 != nil {
+			return "", fmt.Errorf("fs: read file: %w", err2)
+		}
+
+		f, err2 := parser.ParseFile(token.NewFileSet(), goFile, src, parser.PackageClauseOnly)
+		if err2 != nil {
+			continue
+		}
+
+		return f.Name.Name, nil
+	}
+
+	if name := filepath.Base(dir); p.ValidatePkgName(name) == nil {
+		return name, nil
+	}
+
+	return "port", nil
+}
+
+// ValidateMethodSignature reports whether the signature is a valid exported
+// interface method like "GetUser(ctx context.Context, id string) error".
+func (p *Project) ValidateMethodSignature(signature string) error {
+	_, err := p.parseMethodSignature(signature)
+	return err
+}
+
+func (*Project) parseMethodSignature(signature string) (string, error) {
+	// the signature is parsed in a throwaway file, so the package name does
+	// not matter
+	src := fmt.Sprintf("package p\n\ntype _ interface {\n%s\n}\n", signature)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.SkipObjectResolution)
+	if err != nil || len(f.Decls) != 1 {
+		return "", customerrors.ErrInvalidMethodSignature{Signature: signature, Message: "syntax error"}
+	}
+
+	ifaceType := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type.(*ast.InterfaceType)
+
+	if len(ifaceType.Methods.List) != 1 || len(ifaceType.Methods.List[0].Names) != 1 {
+		return "", customerrors.ErrInvalidMethodSignature{Signature: signature, Message: "must be a single method"}
+	}
+
+	name := ifaceType.Methods.List[0].Names[0]
+
+	if !name.IsExported() {
+		return "", customerrors.ErrInvalidMethodSignature{Signature: signature, Message: "method must be exported"}
+	}
+
+	return name.Name, nil
+}
@@ -1,19 +1,64 @@
 package project
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+	"github.com/ksckaan1/hexago/internal/pkg/output"
+	"github.com/ksckaan1/hexago/internal/port"
 )
 
+const portTestFile = `package port
+
+import (
+	"context"
+	"io"
+)
+
+/*
+type Commented interface{}
+*/
+
+type (
+	// UserRepository stores users.
+	UserRepository interface {
+		// GetUser returns a user.
+		GetUser(ctx context.Context, id string) (string, error)
+	}
+
+	Repository[K comparable, V any]  interface {
+		Get(key K) (V, error)
+		Set(key K, value V) error
+	}
+)
+
+type UserService interface {
+	UserRepository
+	io.Closer
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type unexported interface{}
+
+var raw = ` + "`type Raw interface{}`" + `
+`
+
 func TestGetAllPorts(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -23,7 +68,7 @@ func TestGetAllPorts(t *testing.T) {
 	}
 	type want struct {
 		err   require.ErrorAssertionFunc
-		ports []string
+		ports []model.Port
 	}
 
 	tests := []struct {
@@ -36,9 +81,8 @@ func TestGetAllPorts(t *testing.T) {
 			name: "valid",
 			in: in{
 				preRun: func(p *Project) error {
-					dir := t.TempDir()
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: dir,
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -47,7 +91,7 @@ func TestGetAllPorts(t *testing.T) {
 					}
 
 					for i := range 3 {
-						err = os.WriteFile(filepath.Join(dir, "internal", "port", fmt.Sprintf("example%d.go", i)), []byte(fmt.Sprintf("package port\ntype Example%d interface {}\n", i)), 0o644)
+						err = p.fs.WriteFile(filepath.Join("internal", "port", fmt.Sprintf("example%d.go", i)), []byte(fmt.Sprintf("package port\ntype Example%d interface {}\n", i)), 0o644)
 						if err != nil {
 							return err
 						}
@@ -61,8 +105,12 @@ func TestGetAllPorts(t *testing.T) {
 				targetDomain: "core",
 			},
 			want: want{
-				err:   require.NoError,
-				ports: []string{"Example0", "Example1", "Example2"},
+				err: require.NoError,
+				ports: []model.Port{
+					{Component: model.Component{Kind: model.KindPort, Name: "Example0", Path: filepath.Join("internal", "port", "example0.go")}, Package: "port", ImportPath: "my-project/internal/port", Methods: []model.PortMethod{}},
+					{Component: model.Component{Kind: model.KindPort, Name: "Example1", Path: filepath.Join("internal", "port", "example1.go")}, Package: "port", ImportPath: "my-project/internal/port", Methods: []model.PortMethod{}},
+					{Component: model.Component{Kind: model.KindPort, Name: "Example2", Path: filepath.Join("internal", "port", "example2.go")}, Package: "port", ImportPath: "my-project/internal/port", Methods: []model.PortMethod{}},
+				},
 			},
 		},
 		{
@@ -70,7 +118,7 @@ func TestGetAllPorts(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -82,21 +130,700 @@ func TestGetAllPorts(t *testing.T) {
 			},
 			want: want{
 				err:   require.NoError,
-				ports: []string{},
+				ports: []model.Port{},
+			},
+		},
+		{
+			name: "grouped, generic and embedded interfaces",
+			in: in{
+				preRun: func(p *Project) error {
+					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
+						ProjectDirectory: ".",
+						ModuleName:       "my-project",
+						CreateModule:     true,
+					})
+					if err != nil {
+						return err
+					}
+
+					return p.fs.WriteFile(filepath.Join("internal", "port", "user.go"), []byte(portTestFile), 0o644)
+				},
+			},
+			args: args{
+				ctx:          context.Background,
+				targetDomain: "core",
+			},
+			want: want{
+				err: require.NoError,
+				ports: []model.Port{
+					{
+						Component: model.Component{
+							Kind: model.KindPort,
+							Name: "UserRepository",
+							Path: filepath.Join("internal", "port", "user.go"),
+						},
+						Package:    "port",
+						ImportPath: "my-project/internal/port",
+						Doc:        "UserRepository stores users.",
+						Methods: []model.PortMethod{
+							{Name: "GetUser", Signature: "(ctx context.Context, id string) (string, error)", Doc: "GetUser returns a user."},
+						},
+					},
+					{
+						Component: model.Component{
+							Kind: model.KindPort,
+							Name: "Repository",
+							Path: filepath.Join("internal", "port", "user.go"),
+						},
+						Package:    "port",
+						ImportPath: "my-project/internal/port",
+						TypeParams: "[K comparable, V any]",
+						Methods: []model.PortMethod{
+							{Name: "Get", Signature: "(key K) (V, error)"},
+							{Name: "Set", Signature: "(key K, value V) error"},
+						},
+					},
+					{
+						Component: model.Component{
+							Kind: model.KindPort,
+							Name: "UserService",
+							Path: filepath.Join("internal", "port", "user.go"),
+						},
+						Package:    "port",
+						ImportPath: "my-project/internal/port",
+						Embeds:     []string{"UserRepository", "io.Closer"},
+						Methods:    []model.PortMethod{},
+					},
+				},
+			},
+		},
+		{
+			name: "domain ports",
+			in: in{
+				preRun: func(p *Project) error {
+					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
+						ProjectDirectory: ".",
+						ModuleName:       "my-project",
+						CreateModule:     true,
+					})
+					if err != nil {
+						return err
+					}
+
+					err = p.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"})
+					if err != nil {
+						return err
+					}
+
+					files := map[string]string{
+						filepath.Join("internal", "port", "global.go"):                       "package port\ntype Global interface{}\n",
+						filepath.Join("internal", "domain", "core", "port", "core.go"):       "package port\ntype Core interface{}\n",
+						filepath.Join("internal", "domain", "billing", "port", "billing.go"): "package port\ntype Billing interface{}\n",
+					}
+
+					for name, content := range files {
+						err = p.fs.WriteFile(name, []byte(content), 0o644)
+						if err != nil {
+							return err
+						}
+					}
+
+					return nil
+				},
+			},
+			args: args{
+				ctx:          context.Background,
+				targetDomain: "billing",
+			},
+			want: want{
+				err: require.NoError,
+				ports: []model.Port{
+					{
+						Component: model.Component{
+							Kind: model.KindPort,
+							Name: "Global",
+							Path: filepath.Join("internal", "port", "global.go"),
+						},
+						Package:    "port",
+						ImportPath: "my-project/internal/port",
+						Methods:    []model.PortMethod{},
+					},
+					{
+						Component: model.Component{
+							Kind:   model.KindPort,
+							Name:   "Billing",
+							Domain: "billing",
+							Path:   filepath.Join("internal", "domain", "billing", "port", "billing.go"),
+						},
+						Package:    "port",
+						ImportPath: "my-project/internal/domain/billing/port",
+						Methods:    []model.PortMethod{},
+					},
+				},
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
-			ports, err := projectService.GetAllPorts(tt.args.ctx())
+			ports, err := projectService.GetAllPorts(tt.args.ctx(), tt.args.targetDomain)
 			tt.want.err(t, err)
 			require.Equal(t, tt.want.ports, ports)
 		})
 	}
 }
+
+func TestFindPort(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		portName     string
+		targetDomain string
+	}
+	type want struct {
+		err        require.ErrorAssertionFunc
+		importPath string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "target domain has priority",
+			args: args{
+				portName:     "Repository",
+				targetDomain: "billing",
+			},
+			want: want{
+				err:        require.NoError,
+				importPath: "my-project/internal/domain/billing/port",
+			},
+		},
+		{
+			name: "global port",
+			args: args{
+				portName:     "Repository",
+				targetDomain: "",
+			},
+			want: want{
+				err:        require.NoError,
+				importPath: "my-project/internal/port",
+			},
+		},
+		{
+			name: "port of another domain",
+			args: args{
+				portName:     "Invoice",
+				targetDomain: "core",
+			},
+			want: want{
+				err:        require.NoError,
+				importPath: "my-project/internal/domain/billing/port",
+			},
+		},
+		{
+			name: "ambiguous port",
+			args: args{
+				portName:     "Notifier",
+				targetDomain: "",
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrAmbiguousPort{})
+				},
+			},
+		},
+		{
+			name: "not found",
+			args: args{
+				portName:     "NotExisting",
+				targetDomain: "core",
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorIs(tt, err, customerrors.ErrInterfaceNotFound{Name: "NotExisting"})
+				},
+			},
+		},
+	}
+
+	root := t.TempDir()
+
+	projectService := &Project{
+		cfg:  &config.Config{},
+		root: root,
+		fs:   filesystem.NewOS(root),
+	}
+
+	err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	err = projectService.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"})
+	require.NoError(t, err)
+
+	files := map[string]string{
+		filepath.Join("internal", "port", "port.go"):                          "package port\ntype Repository interface{}\n",
+		filepath.Join("internal", "domain", "core", "port", "port.go"):        "package port\ntype Notifier interface{}\n",
+		filepath.Join("internal", "domain", "billing", "port", "port.go"):     "package port\ntype Repository interface{}\ntype Invoice interface{}\n",
+		filepath.Join("internal", "domain", "billing", "port", "notifier.go"): "package port\ntype Notifier interface{}\n",
+	}
+
+	for name, content := range files {
+		require.NoError(t, projectService.fs.WriteFile(name, []byte(content), 0o644))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := projectService.findPort(context.Background(), tt.args.portName, tt.args.targetDomain)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tt.want.importPath, port.ImportPath)
+		})
+	}
+}
+
+func TestCreatePort(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		memory bool
+		preRun func(p *Project) error
+	}
+	type args struct {
+		params model.CreatePortParams
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		portFile string
+		content  string
+	}
+
+	initProject := func(p *Project) error {
+		return p.InitNewProject(context.Background(), model.InitNewProjectParams{
+			ProjectDirectory: ".",
+			ModuleName:       "my-project",
+			CreateModule:     true,
+		})
+	}
+
+	tests := []struct {
+		name string
+		in
+		args
+		want
+	}{
+		{
+			name: "new file",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods: []string{
+						"GetUser(ctx context.Context, id string) (string, error)",
+						"DeleteUser(ctx context.Context, id string) error",
+					},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "port", "userrepository.go"),
+				content: "package port\n\nimport \"context\"\n\n" +
+					"type UserRepository interface {\n" +
+					"\tGetUser(ctx context.Context, id string) (string, error)\n" +
+					"\tDeleteUser(ctx context.Context, id string) error\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "append to existing file in domain",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(
+						filepath.Join("internal", "domain", "core", "port", "user.go"),
+						[]byte("package port\n\ntype UserService interface{}\n"),
+						0o644,
+					)
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					TargetDomain: "core",
+					PortName:     "UserRepository",
+					FileName:     "user",
+					Methods:      []string{"Now() time.Time"},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "domain", "core", "port", "user.go"),
+				content: "package port\n\nimport \"time\"\n\n" +
+					"type UserService interface{}\n\n" +
+					"type UserRepository interface {\n" +
+					"\tNow() time.Time\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "new file in a directory of another package name",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(
+						filepath.Join("internal", "port", "doc.go"),
+						[]byte("// Package contracts holds the ports.\npackage contracts\n"),
+						0o644,
+					)
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "Clock",
+					Methods:  []string{"Now() time.Time"},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "port", "clock.go"),
+				content: "package contracts\n\nimport \"time\"\n\n" +
+					"type Clock interface {\n" +
+					"\tNow() time.Time\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "imports of the files in the same directory are used",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = p.fs.MkdirAll(filepath.Join("internal", "dto"), 0o755)
+					if err != nil {
+						return err
+					}
+					err = p.fs.WriteFile(
+						filepath.Join("internal", "dto", "user.go"),
+						[]byte("package dto\n\ntype User struct{}\n"),
+						0o644,
+					)
+					if err != nil {
+						return err
+					}
+					err = p.fs.MkdirAll(filepath.Join("internal", "port"), 0o755)
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(
+						filepath.Join("internal", "port", "user.go"),
+						[]byte("package port\n\nimport \"my-project/internal/dto\"\n\ntype UserService interface {\n\tCreate(u dto.User) error\n}\n"),
+						0o644,
+					)
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods:  []string{"GetUser(id string) (*dto.User, error)"},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "port", "userrepository.go"),
+				content: "package port\n\nimport \"my-project/internal/dto\"\n\n" +
+					"type UserRepository interface {\n" +
+					"\tGetUser(id string) (*dto.User, error)\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "packages of the project in memory are imported",
+			in: in{
+				memory: true,
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = p.fs.MkdirAll(filepath.Join("internal", "dto"), 0o755)
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(
+						filepath.Join("internal", "dto", "user.go"),
+						[]byte("package dto\n\ntype User struct{}\n"),
+						0o644,
+					)
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods:  []string{"GetUser(ctx context.Context, id string) (*dto.User, error)"},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "port", "userrepository.go"),
+				content: "package port\n\nimport (\n\t\"context\"\n\t\"my-project/internal/dto\"\n)\n\n" +
+					"type UserRepository interface {\n" +
+					"\tGetUser(ctx context.Context, id string) (*dto.User, error)\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "aliased imports of the files in memory are used",
+			in: in{
+				memory: true,
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					err = p.fs.MkdirAll(filepath.Join("internal", "dto"), 0o755)
+					if err != nil {
+						return err
+					}
+					err = p.fs.WriteFile(
+						filepath.Join("internal", "dto", "user.go"),
+						[]byte("package dto\n\ntype User struct{}\n"),
+						0o644,
+					)
+					if err != nil {
+						return err
+					}
+					err = p.fs.MkdirAll(filepath.Join("internal", "port"), 0o755)
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(
+						filepath.Join("internal", "port", "user.go"),
+						[]byte("package port\n\nimport entity \"my-project/internal/dto\"\n\ntype UserService interface {\n\tCreate(u entity.User) error\n}\n"),
+						0o644,
+					)
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods:  []string{"GetUser(id string) (*entity.User, error)"},
+				},
+			},
+			want: want{
+				err:      require.NoError,
+				portFile: filepath.Join("internal", "port", "userrepository.go"),
+				content: "package port\n\nimport entity \"my-project/internal/dto\"\n\n" +
+					"type UserRepository interface {\n" +
+					"\tGetUser(id string) (*entity.User, error)\n" +
+					"}\n",
+			},
+		},
+		{
+			name: "invalid method signature",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods:  []string{"GetUser(ctx context.Context"},
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrInvalidMethodSignature{})
+				},
+			},
+		},
+		{
+			name: "unexported method",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+					Methods:  []string{"getUser() error"},
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrInvalidMethodSignature{})
+				},
+			},
+		},
+		{
+			name: "already existing",
+			in: in{
+				preRun: func(p *Project) error {
+					err := initProject(p)
+					if err != nil {
+						return err
+					}
+					_, err = p.CreatePort(context.Background(), model.CreatePortParams{
+						PortName: "UserRepository",
+						FileName: "other",
+					})
+					return err
+				},
+			},
+			args: args{
+				params: model.CreatePortParams{
+					PortName: "UserRepository",
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorIs(tt, err, customerrors.ErrAlreadyExist)
+				},
+			},
+		},
+		{
+			name: "domain not found",
+			in: in{
+				preRun: initProject,
+			},
+			args: args{
+				params: model.CreatePortParams{
+					TargetDomain: "notexisting",
+					PortName:     "UserRepository",
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorIs(tt, err, customerrors.ErrDomainNotFound)
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   lo.Ternary[port.FileSystem](tt.in.memory, filesystem.NewMemory(filesystem.NewOS(root)), filesystem.NewOS(root)),
+			}
+			require.NoError(t, tt.in.preRun(projectService))
+
+			portFile, err := projectService.CreatePort(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.portFile, portFile)
+
+			if tt.want.content != "" {
+				content, err := projectService.fs.ReadFile(portFile)
+				require.NoError(t, err)
+				require.Equal(t, tt.want.content, string(content))
+			}
+
+			if tt.in.memory {
+				require.NoFileExists(t, filepath.Join(root, tt.want.portFile))
+			}
+		})
+	}
+}
+
+func TestPortOutput(t *testing.T) {
+	t.Parallel()
+
+	// ports keep the fields of the components at the top level, so the
+	// scripts written for component ls can read the port ls output
+	ports := []model.Port{
+		{
+			Component: model.Component{
+				Kind:   model.KindPort,
+				Name:   "Billing",
+				Domain: "billing",
+				Path:   "internal/domain/billing/port/billing.go",
+			},
+			Package:    "port",
+			ImportPath: "my-project/internal/domain/billing/port",
+			Methods:    []model.PortMethod{},
+		},
+	}
+
+	type want struct {
+		output string
+	}
+
+	tests := []struct {
+		name   string
+		format output.Format
+		want
+	}{
+		{
+			name:   "json",
+			format: output.FormatJSON,
+			want: want{
+				output: `[
+  {
+    "kind": "port",
+    "name": "Billing",
+    "domain": "billing",
+    "path": "internal/domain/billing/port/billing.go",
+    "package": "port",
+    "import_path": "my-project/internal/domain/billing/port",
+    "methods": []
+  }
+]
+`,
+			},
+		},
+		{
+			name:   "yaml",
+			format: output.FormatYAML,
+			want: want{
+				output: `- kind: port
+  name: Billing
+  domain: billing
+  path: internal/domain/billing/port/billing.go
+  package: port
+  import_path: my-project/internal/domain/billing/port
+  methods: []
+`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, output.Print(buf, tt.format, ports))
+			require.Equal(t, tt.want.output, buf.String())
+		})
+	}
+}
@@ -3,19 +3,110 @@ package project
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+	"golang.org/x/tools/go/packages"
 
 	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+	"github.com/ksckaan1/hexago/internal/port"
 )
 
 type Project struct {
-	cfg *config.Config
+	cfg  *config.Config
+	root string
+	wd   string
+	fs   port.FileSystem
+}
+
+type Option func(p *Project)
+
+// WithRoot sets the directory of the project. All paths are relative to it.
+// Defaults to the working directory. See FindRoot to discover it.
+func WithRoot(root string) Option {
+	return func(p *Project) {
+		p.root = root
+	}
+}
+
+// WithFileSystem sets the file system which is rooted at the project
+// directory. Defaults to the disk. Commands which type check the packages,
+// like port sync or mv, load them with the go command, which reads the disk,
+// so the file system must be the disk or provide its changes as an overlay.
+func WithFileSystem(fsys port.FileSystem) Option {
+	return func(p *Project) {
+		p.fs = fsys
+	}
 }
 
-func New(cfg *config.Config) (*Project, error) {
-	return &Project{
-		cfg: cfg,
-	}, nil
+func New(cfg *config.Config, opts ...Option) (*Project, error) {
+	p := &Project{
+		cfg:  cfg,
+		root: ".",
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("os: getwd: %w", err)
+	}
+	p.wd = wd
+
+	root, err := filepath.Abs(p.root)
+	if err != nil {
+		return nil, fmt.Errorf("filepath: abs: %w", err)
+	}
+	p.root = root
+
+	if p.fs == nil {
+		p.fs = filesystem.NewOS(p.root)
+	}
+
+	return p, nil
+}
+
+// Root returns the directory of the project.
+func (p *Project) Root() string {
+	return p.root
+}
+
+// overlayFileSystem keeps its changes out of the disk, like filesystem.Memory.
+type overlayFileSystem interface {
+	Overlay() (map[string][]byte, error)
+}
+
+// packagesConfig returns the config to load the packages of the project. The
+// go command reads the disk, so the files written to a file system which is
+// not the disk are passed as an overlay.
+func (p *Project) packagesConfig(ctx context.Context, mode packages.LoadMode) (*packages.Config, error) {
+	cfg := &packages.Config{
+		Context: ctx,
+		Dir:     p.root,
+		Mode:    mode,
+	}
+
+	switch fsys := p.fs.(type) {
+	case *filesystem.OS:
+		return cfg, nil
+	case overlayFileSystem:
+		overlay, err := fsys.Overlay()
+		if err != nil {
+			return nil, customerrors.ErrPackagesNotLoadable{Reason: err.Error()}
+		}
+		cfg.Overlay = lo.MapKeys(overlay, func(_ []byte, name string) string {
+			return filepath.Join(p.root, name)
+		})
+		return cfg, nil
+	default:
+		return nil, customerrors.ErrPackagesNotLoadable{Reason: fmt.Sprintf("%T has no overlay", p.fs)}
+	}
 }
 
 func (p *Project) InitNewProject(ctx context.Context, params model.InitNewProjectParams) error {
@@ -35,13 +126,13 @@ func (p *Project) InitNewProject(ctx context.Context, params model.InitNewProjec
 	}
 
 	if params.CreateModule {
-		err = p.initGoModule(ctx, params.ModuleName)
+		err = p.initGoModule(ctx, projectPath, params.ModuleName)
 		if err != nil {
 			return fmt.Errorf("init go module: %w", err)
 		}
 	}
 
-	err = p.createProjectSubDirs()
+	err = p.createProjectSubDirs(projectPath)
 	if err != nil {
 		return fmt.Errorf("create project dirs: %w", err)
 	}
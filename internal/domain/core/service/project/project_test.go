@@ -2,15 +2,24 @@ package project
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
 
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+	"github.com/ksckaan1/hexago/internal/port"
 )
 
 func TestInitNewProject(t *testing.T) {
+	t.Parallel()
+
 	type args struct {
 		ctx           func() context.Context
 		projectFolder string
@@ -73,19 +82,235 @@ func TestInitNewProject(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			projectService := &Project{}
+			t.Parallel()
+
+			root := t.TempDir()
 
-			projectDir := filepath.Join(t.TempDir(), tt.args.projectFolder)
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
 
 			err := projectService.InitNewProject(
 				tt.args.ctx(),
 				model.InitNewProjectParams{
-					ProjectDirectory: projectDir,
+					ProjectDirectory: tt.args.projectFolder,
 					ModuleName:       tt.args.moduleName,
 				},
 			)
 
 			tt.want.err(t, err)
+			require.DirExists(t, filepath.Join(root, tt.args.projectFolder, ".hexago"))
+		})
+	}
+}
+
+func TestProjectWithMemoryFileSystem(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	fsys := filesystem.NewMemory(nil)
+
+	projectService, err := New(&config.Config{}, WithRoot(root), WithFileSystem(fsys))
+	require.NoError(t, err)
+
+	err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, projectService.CreateDomain(context.Background(), model.CreateDomainParams{DomainName: "billing"}))
+
+	serviceFile, err := projectService.CreateService(context.Background(), model.CreateServiceParams{
+		TargetDomain: "billing",
+		StructName:   "Invoice",
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("internal", "domain", "billing", "service", "invoice", "invoice.go"), serviceFile)
+
+	services, err := projectService.GetAllServices(context.Background(), "billing")
+	require.NoError(t, err)
+	require.Equal(t, []string{"invoice"}, services)
+
+	content, err := fsys.ReadFile(serviceFile)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "type Invoice struct{}")
+
+	moduleName, err := projectService.GetModuleName()
+	require.NoError(t, err)
+	require.Equal(t, "my-project", moduleName)
+
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestPackagesConfig(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		fsys func(root string) port.FileSystem
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		overlay []string
+	}
+
+	serviceFile := filepath.Join("internal", "domain", "core", "service", "order", "order.go")
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "disk",
+			args: args{
+				fsys: func(root string) port.FileSystem {
+					return filesystem.NewOS(root)
+				},
+			},
+			want: want{
+				err: require.NoError,
+			},
+		},
+		{
+			name: "memory on top of the disk",
+			args: args{
+				fsys: func(root string) port.FileSystem {
+					return filesystem.NewMemory(filesystem.NewOS(root))
+				},
+			},
+			want: want{
+				err:     require.NoError,
+				overlay: []string{serviceFile},
+			},
+		},
+		{
+			name: "dry run",
+			args: args{
+				fsys: func(root string) port.FileSystem {
+					fsys := filesystem.NewDryRun(filesystem.NewOS(root))
+					fsys.EnableDryRun()
+					return fsys
+				},
+			},
+			want: want{
+				err:     require.NoError,
+				overlay: []string{serviceFile},
+			},
+		},
+		{
+			name: "memory without a base",
+			args: args{
+				fsys: func(root string) port.FileSystem {
+					fsys := filesystem.NewMemory(nil)
+					require.NoError(t, (&Project{cfg: &config.Config{}, root: root, fs: fsys}).InitNewProject(context.Background(), model.InitNewProjectParams{
+						ProjectDirectory: ".",
+						ModuleName:       "my-project",
+						CreateModule:     true,
+					}))
+					return fsys
+				},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrPackagesNotLoadable{})
+				},
+			},
+		},
+		{
+			name: "file system without an overlay",
+			args: args{
+				fsys: func(root string) port.FileSystem {
+					return failingRenameFS{FileSystem: filesystem.NewOS(root)}
+				},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrPackagesNotLoadable{})
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
+
+			err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			projectService.fs = tt.args.fsys(root)
+
+			_, err = projectService.CreateService(context.Background(), model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "Order",
+			})
+			require.NoError(t, err)
+
+			cfg, err := projectService.packagesConfig(context.Background(), packages.NeedName)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+
+			require.ElementsMatch(t, lo.Map(tt.want.overlay, func(name string, _ int) string {
+				return filepath.Join(root, name)
+			}), lo.Keys(cfg.Overlay))
+
+			// the service is type checked even if it is only in memory
+			_, err = projectService.loadPackage(context.Background(), "my-project/internal/domain/core/service/order")
+			require.NoError(t, err)
 		})
 	}
 }
+
+func TestPackagesConfigRemovedFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	projectService := &Project{
+		cfg:  &config.Config{},
+		root: root,
+		fs:   filesystem.NewOS(root),
+	}
+
+	err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	_, err = projectService.CreateService(context.Background(), model.CreateServiceParams{
+		TargetDomain: "core",
+		StructName:   "User",
+	})
+	require.NoError(t, err)
+
+	fsys := filesystem.NewMemory(filesystem.NewOS(root))
+	projectService.fs = fsys
+
+	require.NoError(t, fsys.RemoveAll(filepath.Join("internal", "domain", "core", "service", "user")))
+
+	// the go command would still read the removed files from the disk
+	_, err = projectService.packagesConfig(context.Background(), packages.NeedName)
+	require.ErrorAs(t, err, &customerrors.ErrPackagesNotLoadable{})
+}
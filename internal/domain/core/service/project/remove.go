@@ -0,0 +1,141 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+// PlanRemoval returns what will be deleted by removing the component, the
+// imports of the component from the packages outside of it, and the runner
+// entries of the config which belong to it.
+func (p *Project) PlanRemoval(ctx context.Context, params model.RemoveComponentParams) (*model.RemovalPlan, error) {
+	component, err := p.findComponent(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("find component: %w", err)
+	}
+
+	files := make([]string, 0)
+
+	err = fs.WalkDir(p.fs, filepath.ToSlash(component.Path), func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			files = append(files, filepath.FromSlash(filePath))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fs: walk dir: %w", err)
+	}
+
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	imports, err := p.collectModuleImports(moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("collect module imports: %w", err)
+	}
+
+	componentDir := filepath.ToSlash(component.Path)
+
+	importers := make([]model.Importer, 0)
+
+	for _, imp := range imports {
+		if !isInDir(imp.targetDir, componentDir) || isInDir(imp.pkgDir, componentDir) {
+			continue
+		}
+
+		importers = append(importers, model.Importer{
+			Package: imp.pkgDir,
+			File:    imp.file,
+			Line:    imp.line,
+		})
+	}
+
+	slices.SortFunc(importers, func(a, b model.Importer) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+		)
+	})
+
+	runners := make([]string, 0)
+
+	if component.Kind == model.KindEntryPoint {
+		if _, err2 := p.cfg.GetRunner(component.Name); err2 == nil {
+			runners = append(runners, component.Name)
+		}
+	}
+
+	return &model.RemovalPlan{
+		Component: *component,
+		Files:     files,
+		Importers: importers,
+		Runners:   runners,
+	}, nil
+}
+
+// RemoveComponent deletes the directory of the planned component and removes
+// its runner entries from the config.
+func (p *Project) RemoveComponent(_ context.Context, plan *model.RemovalPlan) error {
+	err := p.fs.RemoveAll(plan.Component.Path)
+	if err != nil {
+		return fmt.Errorf("fs: remove all: %w", err)
+	}
+
+	for _, runner := range plan.Runners {
+		_, err = p.cfg.RemoveRunner(runner)
+		if err != nil {
+			return fmt.Errorf("cfg: remove runner: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Project) findComponent(ctx context.Context, params model.RemoveComponentParams) (*model.Component, error) {
+	components, err := p.GetAllComponents(ctx, params.Kind, params.TargetDomain)
+	if err != nil {
+		return nil, fmt.Errorf("get all components: %w", err)
+	}
+
+	candidates := lo.Filter(components, func(c model.Component, _ int) bool {
+		if c.Name != params.Name {
+			return false
+		}
+		if params.Kind == model.KindPackage {
+			return c.Scope == lo.Ternary(params.IsGlobal, model.ScopeGlobal, model.ScopeInternal)
+		}
+		return true
+	})
+
+	switch len(candidates) {
+	case 0:
+		return nil, customerrors.ErrComponentNotFound{Kind: string(params.Kind), Name: params.Name}
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, fmt.Errorf("%s %s exists in multiple domains: %s (domain must be given)", params.Kind, params.Name,
+			strings.Join(lo.Map(candidates, func(c model.Component, _ int) string {
+				return c.Domain
+			}), ", "))
+	}
+}
+
+// isInDir reports whether the slash separated dir is the parent dir or inside it.
+func isInDir(dir, parent string) bool {
+	return dir == parent || strings.HasPrefix(dir, parent+"/")
+}
@@ -0,0 +1,230 @@
+package project
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func TestRemoveComponent(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		preRun func(p *Project) error
+	}
+	type args struct {
+		params model.RemoveComponentParams
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		plan    *model.RemovalPlan
+		removed []string
+		kept    []string
+		runners []string
+	}
+
+	tests := []struct {
+		name string
+		in
+		args
+		want
+	}{
+		{
+			name: "imported infrastructure",
+			in: in{
+				preRun: func(p *Project) error {
+					_, err := p.CreateInfrastructure(context.Background(), model.CreateInfraParams{StructName: "Cache"})
+					if err != nil {
+						return err
+					}
+					_, err = p.CreateService(context.Background(), model.CreateServiceParams{
+						TargetDomain: "core",
+						StructName:   "User",
+					})
+					if err != nil {
+						return err
+					}
+					return p.fs.WriteFile(filepath.Join("internal", "domain", "core", "service", "user", "cache.go"), []byte(`package user
+
+import _ "my-project/internal/infrastructure/cache"
+`), 0o644)
+				},
+			},
+			args: args{
+				params: model.RemoveComponentParams{Kind: model.KindInfrastructure, Name: "cache"},
+			},
+			want: want{
+				err: require.NoError,
+				plan: &model.RemovalPlan{
+					Component: model.Component{
+						Kind: model.KindInfrastructure,
+						Name: "cache",
+						Path: filepath.Join("internal", "infrastructure", "cache"),
+					},
+					Files: []string{filepath.Join("internal", "infrastructure", "cache", "cache.go")},
+					Importers: []model.Importer{
+						{
+							Package: "internal/domain/core/service/user",
+							File:    filepath.Join("internal", "domain", "core", "service", "user", "cache.go"),
+							Line:    3,
+						},
+					},
+					Runners: []string{},
+				},
+				removed: []string{filepath.Join("internal", "infrastructure", "cache")},
+			},
+		},
+		{
+			name: "entry point with runner",
+			in: in{
+				preRun: func(p *Project) error {
+					_, err := p.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{PackageName: "api"})
+					if err != nil {
+						return err
+					}
+					cfgPath := filepath.Join(".hexago", "config.yaml")
+					content, err := p.fs.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					content = bytes.Replace(content, []byte("runners:\n"), []byte("runners:\n  api:\n    env:\n      - PORT=8080\n  worker:\n    cmd: go run ./cmd/worker\n"), 1)
+					err = p.fs.WriteFile(cfgPath, content, 0o644)
+					if err != nil {
+						return err
+					}
+					return p.cfg.Load()
+				},
+			},
+			args: args{
+				params: model.RemoveComponentParams{Kind: model.KindEntryPoint, Name: "api"},
+			},
+			want: want{
+				err: require.NoError,
+				plan: &model.RemovalPlan{
+					Component: model.Component{
+						Kind: model.KindEntryPoint,
+						Name: "api",
+						Path: filepath.Join("cmd", "api"),
+					},
+					Files:     []string{filepath.Join("cmd", "api", "main.go")},
+					Importers: []model.Importer{},
+					Runners:   []string{"api"},
+				},
+				removed: []string{filepath.Join("cmd", "api")},
+				runners: []string{"worker"},
+			},
+		},
+		{
+			name: "global package",
+			in: in{
+				preRun: func(p *Project) error {
+					_, err := p.CreatePackage(context.Background(), model.CreatePackageParams{StructName: "Util", IsGlobal: true})
+					if err != nil {
+						return err
+					}
+					_, err = p.CreatePackage(context.Background(), model.CreatePackageParams{StructName: "Util"})
+					return err
+				},
+			},
+			args: args{
+				params: model.RemoveComponentParams{Kind: model.KindPackage, Name: "util", IsGlobal: true},
+			},
+			want: want{
+				err: require.NoError,
+				plan: &model.RemovalPlan{
+					Component: model.Component{
+						Kind:  model.KindPackage,
+						Name:  "util",
+						Path:  filepath.Join("pkg", "util"),
+						Scope: model.ScopeGlobal,
+					},
+					Files:     []string{filepath.Join("pkg", "util", "util.go")},
+					Importers: []model.Importer{},
+					Runners:   []string{},
+				},
+				removed: []string{filepath.Join("pkg", "util")},
+				kept:    []string{filepath.Join("internal", "pkg", "util")},
+			},
+		},
+		{
+			name: "component not found",
+			in: in{
+				preRun: func(*Project) error { return nil },
+			},
+			args: args{
+				params: model.RemoveComponentParams{Kind: model.KindService, Name: "unknown"},
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorAs(t, err, &customerrors.ErrComponentNotFound{})
+				},
+				plan: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			fsys := filesystem.NewOS(root)
+
+			cfg, err := config.New(filepath.Join(".hexago", "config.yaml"), fsys)
+			require.NoError(t, err)
+
+			projectService := &Project{
+				cfg:  cfg,
+				root: root,
+				fs:   fsys,
+			}
+
+			err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, tt.in.preRun(projectService))
+
+			plan, err := projectService.PlanRemoval(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.plan, plan)
+
+			if plan == nil {
+				return
+			}
+
+			require.NoError(t, projectService.RemoveComponent(context.Background(), plan))
+
+			for _, name := range tt.want.removed {
+				require.NoDirExists(t, filepath.Join(root, name))
+			}
+
+			for _, name := range tt.want.kept {
+				require.DirExists(t, filepath.Join(root, name))
+			}
+
+			require.NoError(t, cfg.Load())
+
+			for _, runner := range plan.Runners {
+				_, err = cfg.GetRunner(runner)
+				require.ErrorIs(t, err, customerrors.ErrRunnerNotImplemented)
+			}
+
+			for _, runner := range tt.want.runners {
+				_, err = cfg.GetRunner(runner)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// FindRoot walks up from dir and returns the nearest directory which has a
+// .hexago directory. If there is none, the nearest directory which has a
+// go.mod file is returned. It reports false if neither is found.
+func FindRoot(dir string) (string, bool) {
+	for _, marker := range []string{".hexago", "go.mod"} {
+		root, ok := findUp(dir, marker)
+		if ok {
+			return root, true
+		}
+	}
+	return dir, false
+}
+
+func findUp(dir, name string) (string, bool) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// DisplayPath converts a path relative to the project root into a path
+// relative to the working directory, so it can be printed to the user.
+func (p *Project) DisplayPath(name string) string {
+	if p.wd == "" || filepath.IsAbs(name) {
+		return name
+	}
+
+	relPath, err := filepath.Rel(p.wd, filepath.Join(p.root, name))
+	if err != nil {
+		return name
+	}
+
+	return relPath
+}
@@ -0,0 +1,142 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRoot(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		dirs  []string
+		files []string
+		start string
+	}
+	type want struct {
+		root string
+		ok   bool
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "hexago dir in start",
+			args: args{
+				dirs:  []string{".hexago"},
+				files: []string{"go.mod"},
+				start: ".",
+			},
+			want: want{
+				root: ".",
+				ok:   true,
+			},
+		},
+		{
+			name: "hexago dir above start",
+			args: args{
+				dirs:  []string{".hexago", filepath.Join("internal", "domain", "core")},
+				start: filepath.Join("internal", "domain", "core"),
+			},
+			want: want{
+				root: ".",
+				ok:   true,
+			},
+		},
+		{
+			name: "hexago dir preferred over nearer go.mod",
+			args: args{
+				dirs:  []string{".hexago", filepath.Join("tools", "gen")},
+				files: []string{filepath.Join("tools", "go.mod")},
+				start: filepath.Join("tools", "gen"),
+			},
+			want: want{
+				root: ".",
+				ok:   true,
+			},
+		},
+		{
+			name: "nearest go.mod without hexago dir",
+			args: args{
+				dirs:  []string{filepath.Join("tools", "gen")},
+				files: []string{"go.mod", filepath.Join("tools", "go.mod")},
+				start: filepath.Join("tools", "gen"),
+			},
+			want: want{
+				root: "tools",
+				ok:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			base := t.TempDir()
+
+			for _, dir := range tt.args.dirs {
+				require.NoError(t, os.MkdirAll(filepath.Join(base, dir), 0o755))
+			}
+			for _, file := range tt.args.files {
+				require.NoError(t, os.WriteFile(filepath.Join(base, file), nil, 0o644))
+			}
+
+			root, ok := FindRoot(filepath.Join(base, tt.args.start))
+			require.Equal(t, tt.want.ok, ok)
+			require.Equal(t, filepath.Join(base, tt.want.root), root)
+		})
+	}
+}
+
+func TestDisplayPath(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	tests := []struct {
+		name string
+		wd   string
+		path string
+		want string
+	}{
+		{
+			name: "working dir is root",
+			wd:   root,
+			path: filepath.Join("internal", "domain", "core", "service", "user", "user.go"),
+			want: filepath.Join("internal", "domain", "core", "service", "user", "user.go"),
+		},
+		{
+			name: "working dir is subdir",
+			wd:   filepath.Join(root, "internal", "domain", "core"),
+			path: filepath.Join("internal", "domain", "core", "service", "user", "user.go"),
+			want: filepath.Join("service", "user", "user.go"),
+		},
+		{
+			name: "path outside working dir",
+			wd:   filepath.Join(root, "internal", "domain", "core"),
+			path: filepath.Join("cmd", "api", "main.go"),
+			want: filepath.Join("..", "..", "..", "cmd", "api", "main.go"),
+		},
+		{
+			name: "unknown working dir",
+			path: filepath.Join("cmd", "api", "main.go"),
+			want: filepath.Join("cmd", "api", "main.go"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &Project{root: root, wd: tt.wd}
+			require.Equal(t, tt.want, p.DisplayPath(tt.path))
+		})
+	}
+}
@@ -67,7 +67,8 @@ func (p *Project) prepareLogFiles(commandName string, runner *config.Runner, cmd
 		return nil, nil
 	}
 
-	err := os.MkdirAll("logs", 0o755)
+	// log files are streamed, so they are always written to the disk
+	err := os.MkdirAll(filepath.Join(p.root, "logs"), 0o755)
 	if err != nil {
 		return nil, fmt.Errorf("os: mkdir all: %w", err)
 	}
@@ -105,7 +106,7 @@ func (p *Project) prepareLogFiles(commandName string, runner *config.Runner, cmd
 }
 
 func (p *Project) createLogFile(name string, overwrite bool) (*os.File, error) {
-	filePath := filepath.Join("logs", fmt.Sprintf("%s.log", name))
+	filePath := filepath.Join(p.root, "logs", fmt.Sprintf("%s.log", name))
 	if !overwrite {
 		logFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_APPEND, 0o644)
 		if err == nil {
@@ -126,6 +127,7 @@ func (p *Project) runCmd(ctx context.Context, commandName string, runner *config
 	}
 
 	cmd := exec.CommandContext(ctx, term, "-c", runner.Cmd)
+	cmd.Dir = p.root
 	cmd.Env = envs
 	cmd.Stdin = os.Stdin
 
@@ -3,7 +3,6 @@ package project
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -20,15 +19,15 @@ func (p *Project) GetAllServices(ctx context.Context, targetDomain string) ([]st
 		return nil, fmt.Errorf("is domain exist: %w", err)
 	}
 
-	servicesPath := filepath.Join("internal", "domain", targetDomain, "service")
+	servicesPath := p.servicesDir(targetDomain)
 
-	serviceCandidatePaths, err := filepath.Glob(filepath.Join(servicesPath, "*"))
+	serviceCandidatePaths, err := p.glob(filepath.Join(servicesPath, "*"))
 	if err != nil {
-		return nil, fmt.Errorf("filepath: glob: %w", err)
+		return nil, fmt.Errorf("glob: %w", err)
 	}
 
 	servicePaths := lo.Filter(serviceCandidatePaths, func(d string, _ int) bool {
-		stat, err2 := os.Stat(d)
+		stat, err2 := p.fs.Stat(d)
 		return err2 == nil && stat.IsDir()
 	})
 
@@ -77,34 +76,31 @@ func (p *Project) CreateService(ctx context.Context, params model.CreateServiceP
 		return "", fmt.Errorf("is service exist: %w", customerrors.ErrAlreadyExist)
 	}
 
-	serviceDir := filepath.Join("internal", "domain", params.TargetDomain, "service", params.PackageName)
+	serviceDir := filepath.Join(p.servicesDir(params.TargetDomain), params.PackageName)
 
-	err = os.MkdirAll(serviceDir, 0o755)
+	err = p.fs.MkdirAll(serviceDir, 0o755)
 	if err != nil {
-		return "", fmt.Errorf("os: mkdir all: %w", err)
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
 	}
 
-	serviceFile, err := p.generateGoInitFile(
+	serviceFile, err := p.generateComponentFiles(
 		ctx,
 		serviceDir,
+		params.TargetDomain,
 		params.StructName,
 		params.PackageName,
-		params.PortParam,
+		params.PortParams,
 		TTService,
 		params.AssertInterface,
+		params.Dependencies,
 	)
 	if err != nil {
-		err2 := os.RemoveAll(serviceDir)
+		err2 := p.fs.RemoveAll(serviceDir)
 		if err2 != nil {
-			return "", fmt.Errorf("os: remove all: %w", err2)
+			return "", fmt.Errorf("fs: remove all: %w", err2)
 		}
 		return "", fmt.Errorf("generate service file: %w", err)
 	}
 
-	err = p.formatGoFiles(ctx, serviceFile)
-	if err != nil {
-		return "", fmt.Errorf("format go files: %w", err)
-	}
-
 	return serviceFile, nil
 }
@@ -11,9 +11,12 @@ import (
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 )
 
 func TestCreateService(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -45,7 +48,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "MyService",
 					PackageName:     "myservice",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -61,7 +64,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -73,7 +76,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "MyService",
 					PackageName:     "myservice",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -87,7 +90,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -99,7 +102,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "MyService",
 					PackageName:     "myservice",
-					PortParam:       "io.Writer",
+					PortParams:      []string{"io.Writer"},
 					AssertInterface: true,
 				},
 			},
@@ -113,7 +116,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -125,7 +128,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "MyService",
 					PackageName:     "myservice",
-					PortParam:       "notexisting",
+					PortParams:      []string{"notexisting"},
 					AssertInterface: true,
 				},
 			},
@@ -141,7 +144,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -176,7 +179,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -188,7 +191,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "myService",
 					PackageName:     "myservice",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -204,7 +207,7 @@ func TestCreateService(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -216,7 +219,7 @@ func TestCreateService(t *testing.T) {
 					TargetDomain:    "core",
 					StructName:      "MyService",
 					PackageName:     "my-service",
-					PortParam:       "",
+					PortParams:      nil,
 					AssertInterface: false,
 				},
 			},
@@ -231,8 +234,14 @@ func TestCreateService(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -244,6 +253,8 @@ func TestCreateService(t *testing.T) {
 }
 
 func TestGetAllServices(t *testing.T) {
+	t.Parallel()
+
 	type in struct {
 		preRun func(p *Project) error
 	}
@@ -285,7 +296,7 @@ func TestGetAllServices(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					err := p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -321,7 +332,7 @@ func TestGetAllServices(t *testing.T) {
 			in: in{
 				preRun: func(p *Project) error {
 					return p.InitNewProject(context.Background(), model.InitNewProjectParams{
-						ProjectDirectory: t.TempDir(),
+						ProjectDirectory: ".",
 						ModuleName:       "my-project",
 						CreateModule:     true,
 					})
@@ -340,8 +351,14 @@ func TestGetAllServices(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
 			projectService := &Project{
-				cfg: &config.Config{},
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
 			}
 			require.NoError(t, tt.in.preRun(projectService))
 
@@ -0,0 +1,571 @@
+package project
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+)
+
+// ImplementedInterface is an interface which the generated component
+// implements.
+type ImplementedInterface struct {
+	InterfaceName string
+	InterfaceType string
+	ImportPath    string
+	ImportName    string
+}
+
+type ImplementationDetail struct {
+	Interfaces     []ImplementedInterface
+	Implementation string
+	Receiver       string            // receiver name of the generated methods
+	Imports        map[string]string // import path -> package name
+}
+
+// generateImplementation loads the interfaces described by interfaceParams
+// and generates method stubs of them for the given struct. Methods which
+// appear in more than one interface are generated once. targetPkgPath is the
+// import path of the package which the stubs will be written into, and
+// targetDomain is used to resolve plain port names.
+func (p *Project) generateImplementation(ctx context.Context, targetPkgPath, targetDomain, instanceName string, interfaceParams []string) (*ImplementationDetail, error) {
+	if len(interfaceParams) == 0 {
+		return nil, nil
+	}
+
+	g := newStubGenerator(targetPkgPath)
+
+	for importPath, name := range templateImports {
+		g.names[name] = importPath
+	}
+
+	interfaces := make([]ImplementedInterface, 0, len(interfaceParams))
+	methods := make([]*types.Func, 0)
+
+	// signatures are compared by their qualified strings, since every
+	// interface is loaded into its own type universe
+	signatures := make(map[string]string)   // method name -> signature
+	methodOwners := make(map[string]string) // method name -> interface type
+
+	for _, interfaceParam := range interfaceParams {
+		interfaceInfo, err := p.getInterfaceInfo(ctx, interfaceParam, targetDomain)
+		if err != nil {
+			return nil, fmt.Errorf("get interface info: %w", err)
+		}
+
+		pkg, err := p.loadPackage(ctx, interfaceInfo.ImportPath)
+		if err != nil {
+			return nil, fmt.Errorf("load package: %w", err)
+		}
+
+		ifaceType, err := p.lookupInterface(pkg, interfaceInfo)
+		if err != nil {
+			return nil, fmt.Errorf("lookup interface: %w", err)
+		}
+
+		g.collectDocs(pkg)
+
+		interfaceType := types.TypeString(ifaceType, g.qualifier)
+
+		if slices.ContainsFunc(interfaces, func(i ImplementedInterface) bool {
+			return i.InterfaceType == interfaceType
+		}) {
+			continue
+		}
+
+		for _, m := range g.collectMethods(ifaceType.Underlying().(*types.Interface)) {
+			err = g.checkAccessible(m)
+			if err != nil {
+				return nil, customerrors.ErrCanNotImplement{Interface: interfaceParam, Reason: err.Error()}
+			}
+
+			signature := g.signatureKey(m)
+
+			existing, ok := signatures[m.Name()]
+			if !ok {
+				methods = append(methods, m)
+				signatures[m.Name()] = signature
+				methodOwners[m.Name()] = interfaceType
+				continue
+			}
+
+			if existing != signature {
+				return nil, customerrors.ErrCanNotImplement{
+					Interface: interfaceParam,
+					Reason:    fmt.Sprintf("method %s conflicts with the one in %s", m.Name(), methodOwners[m.Name()]),
+				}
+			}
+		}
+
+		interfaces = append(interfaces, ImplementedInterface{
+			InterfaceName: interfaceInfo.InterfaceName,
+			InterfaceType: interfaceType,
+			ImportPath:    interfaceInfo.ImportPath,
+			ImportName:    g.imports[interfaceInfo.ImportPath],
+		})
+	}
+
+	receiver := receiverName(instanceName, methods)
+
+	return &ImplementationDetail{
+		Interfaces:     interfaces,
+		Implementation: g.generateStubs(receiver, instanceName, methods),
+		Receiver:       receiver,
+		Imports:        g.imports,
+	}, nil
+}
+
+// templateImports are the packages which the built-in templates import. The
+// packages of the interfaces are aliased if their names collide with them.
+var templateImports = map[string]string{
+	doPkgPath:   "do",
+	fxPkgPath:   "fx",
+	wirePkgPath: "wire",
+	"errors":    "errors",
+	"fmt":       "fmt",
+}
+
+// loadPackage type checks the package and its dependencies from source. Export
+// data is not used, since its format depends on the installed go version.
+func (p *Project) loadPackage(ctx context.Context, importPath string) (*packages.Package, error) {
+	cfg, err := p.packagesConfig(ctx, packages.NeedName|packages.NeedImports|packages.NeedDeps|
+		packages.NeedTypes|packages.NeedSyntax|packages.NeedTypesInfo)
+	if err != nil {
+		return nil, fmt.Errorf("packages config: %w", err)
+	}
+
+	pkgs, err := packages.Load(cfg, importPath)
+	if err != nil {
+		return nil, fmt.Errorf("packages: load: %w", err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("package not found: %s", importPath)
+	}
+
+	if len(pkgs[0].Errors) > 0 {
+		return nil, fmt.Errorf("packages: load: %s", pkgs[0].Errors[0].Error())
+	}
+
+	return pkgs[0], nil
+}
+
+// lookupInterface finds the named interface in the package and instantiates it
+// if it is generic. Type arguments are evaluated in the file scope of the
+// interface declaration, so qualified types imported by that file can be used.
+func (p *Project) lookupInterface(pkg *packages.Package, info *InterfaceInfo) (types.Type, error) {
+	obj, ok := pkg.Types.Scope().Lookup(info.InterfaceName).(*types.TypeName)
+	if !ok {
+		return nil, customerrors.ErrInterfaceNotFound{Name: info.InterfaceName}
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || !types.IsInterface(named) {
+		return nil, customerrors.ErrInterfaceNotFound{Name: info.InterfaceName}
+	}
+
+	typeParams := named.TypeParams()
+
+	if typeParams.Len() == 0 {
+		if info.TypeArgs != "" {
+			return nil, customerrors.ErrCanNotImplement{Interface: info.InterfaceName, Reason: "interface is not generic"}
+		}
+		return named, nil
+	}
+
+	typeArgs := make([]types.Type, typeParams.Len())
+
+	if info.TypeArgs == "" {
+		for i := range typeArgs {
+			typeArgs[i] = types.Universe.Lookup("any").Type()
+		}
+	} else {
+		exprs, err := p.parseTypeArgs(info.TypeArgs)
+		if err != nil {
+			return nil, fmt.Errorf("parse type args: %w", err)
+		}
+
+		if len(exprs) != len(typeArgs) {
+			return nil, customerrors.ErrCanNotImplement{
+				Interface: info.InterfaceName,
+				Reason:    fmt.Sprintf("got %d type arguments, want %d", len(exprs), len(typeArgs)),
+			}
+		}
+
+		for i, expr := range exprs {
+			tv, err2 := types.Eval(pkg.Fset, pkg.Types, obj.Pos(), expr)
+			if err2 != nil {
+				return nil, customerrors.ErrCanNotImplement{Interface: info.InterfaceName, Reason: err2.Error()}
+			}
+			typeArgs[i] = tv.Type
+		}
+	}
+
+	inst, err := types.Instantiate(nil, named, typeArgs, true)
+	if err != nil {
+		return nil, customerrors.ErrCanNotImplement{
+			Interface: info.InterfaceName,
+			Reason:    fmt.Sprintf("%s (type arguments can be given like %s[string])", err.Error(), info.InterfaceName),
+		}
+	}
+
+	return inst, nil
+}
+
+// parseTypeArgs splits a type argument list like "[string, model.User]" into
+// its expressions.
+func (p *Project) parseTypeArgs(typeArgs string) ([]string, error) {
+	expr, err := parser.ParseExpr("T" + typeArgs)
+	if err != nil {
+		return nil, fmt.Errorf("parser: parse expr: %w", err)
+	}
+
+	var indices []ast.Expr
+
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		indices = []ast.Expr{e.Index}
+	case *ast.IndexListExpr:
+		indices = e.Indices
+	default:
+		return nil, fmt.Errorf("invalid type arguments: %s", typeArgs)
+	}
+
+	exprs := make([]string, 0, len(indices))
+	for _, index := range indices {
+		exprs = append(exprs, types.ExprString(index))
+	}
+
+	return exprs, nil
+}
+
+type stubGenerator struct {
+	targetPkgPath string
+	docs          map[token.Pos]string
+	imports       map[string]string // import path -> package name
+	names         map[string]string // package name -> import path
+}
+
+func newStubGenerator(targetPkgPath string, pkgs ...*packages.Package) *stubGenerator {
+	g := &stubGenerator{
+		targetPkgPath: targetPkgPath,
+		docs:          make(map[token.Pos]string),
+		imports:       make(map[string]string),
+		names:         make(map[string]string),
+	}
+
+	for _, pkg := range pkgs {
+		g.collectDocs(pkg)
+	}
+
+	return g
+}
+
+// collectDocs collects doc comments of interface methods declared in the
+// package.
+func (g *stubGenerator) collectDocs(pkg *packages.Package) {
+	for _, f := range pkg.Syntax {
+		ast.Inspect(f, func(n ast.Node) bool {
+			iface, ok := n.(*ast.InterfaceType)
+			if !ok {
+				return true
+			}
+			for _, field := range iface.Methods.List {
+				if field.Doc == nil {
+					continue
+				}
+				for _, name := range field.Names {
+					g.docs[name.Pos()] = field.Doc.Text()
+				}
+			}
+			return true
+		})
+	}
+}
+
+// qualifier records every package referred by the generated code, and
+// aliases the packages whose names are taken by other packages.
+func (g *stubGenerator) qualifier(pkg *types.Package) string {
+	if pkg.Path() == g.targetPkgPath {
+		return ""
+	}
+
+	if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+
+	name := pkg.Name()
+	for i := 2; ; i++ {
+		if owner, taken := g.names[name]; !taken || owner == pkg.Path() {
+			break
+		}
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+
+	g.imports[pkg.Path()] = name
+	g.names[name] = pkg.Path()
+
+	return name
+}
+
+// collectMethods returns the methods of the interface in declaration order.
+// Explicit methods come first, then methods of embedded interfaces. go/types
+// sorts the methods by name, so they are sorted back by their positions.
+func (g *stubGenerator) collectMethods(iface *types.Interface) []*types.Func {
+	methods := make([]*types.Func, 0, iface.NumMethods())
+	seen := make(map[string]bool)
+
+	var walk func(it *types.Interface)
+	walk = func(it *types.Interface) {
+		explicit := make([]*types.Func, 0, it.NumExplicitMethods())
+		for i := range it.NumExplicitMethods() {
+			explicit = append(explicit, it.ExplicitMethod(i))
+		}
+		slices.SortStableFunc(explicit, func(a, b *types.Func) int {
+			return cmp.Compare(a.Pos(), b.Pos())
+		})
+
+		for _, m := range explicit {
+			if !seen[m.Name()] {
+				seen[m.Name()] = true
+				methods = append(methods, m)
+			}
+		}
+		for i := range it.NumEmbeddeds() {
+			if embedded, ok := it.EmbeddedType(i).Underlying().(*types.Interface); ok {
+				walk(embedded)
+			}
+		}
+	}
+
+	walk(iface)
+
+	// methods of the complete method set which could not be reached by walking
+	for i := range iface.NumMethods() {
+		m := iface.Method(i)
+		if !seen[m.Name()] {
+			seen[m.Name()] = true
+			methods = append(methods, m)
+		}
+	}
+
+	return methods
+}
+
+// signatureKey returns the qualified types of the parameters and the results
+// of the method, so methods whose parameters are named differently match.
+func (g *stubGenerator) signatureKey(m *types.Func) string {
+	sig := m.Type().(*types.Signature)
+
+	buf := &strings.Builder{}
+
+	for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+		buf.WriteString("(")
+		for i := range tuple.Len() {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			if sig.Variadic() && tuple == sig.Params() && i == tuple.Len()-1 {
+				buf.WriteString("...")
+			}
+			buf.WriteString(types.TypeString(tuple.At(i).Type(), g.qualifier))
+		}
+		buf.WriteString(")")
+	}
+
+	return buf.String()
+}
+
+// checkAccessible reports an error if the method or a type in its signature
+// can not be referred from the target package.
+func (g *stubGenerator) checkAccessible(m *types.Func) error {
+	if !m.Exported() && m.Pkg() != nil && m.Pkg().Path() != g.targetPkgPath {
+		return fmt.Errorf("method %s is unexported", m.Name())
+	}
+
+	seen := make(map[types.Type]bool)
+
+	var check func(t types.Type) error
+	check = func(t types.Type) error {
+		if seen[t] {
+			return nil
+		}
+		seen[t] = true
+
+		switch t := t.(type) {
+		case *types.Named:
+			obj := t.Obj()
+			if !obj.Exported() && obj.Pkg() != nil && obj.Pkg().Path() != g.targetPkgPath {
+				return fmt.Errorf("method %s uses unexported type %s.%s", m.Name(), obj.Pkg().Name(), obj.Name())
+			}
+			for i := range t.TypeArgs().Len() {
+				if err := check(t.TypeArgs().At(i)); err != nil {
+					return err
+				}
+			}
+		case *types.Alias:
+			return check(types.Unalias(t))
+		case *types.Pointer:
+			return check(t.Elem())
+		case *types.Slice:
+			return check(t.Elem())
+		case *types.Array:
+			return check(t.Elem())
+		case *types.Chan:
+			return check(t.Elem())
+		case *types.Map:
+			if err := check(t.Key()); err != nil {
+				return err
+			}
+			return check(t.Elem())
+		case *types.Tuple:
+			for i := range t.Len() {
+				if err := check(t.At(i).Type()); err != nil {
+					return err
+				}
+			}
+		case *types.Signature:
+			if err := check(t.Params()); err != nil {
+				return err
+			}
+			return check(t.Results())
+		case *types.Struct:
+			for i := range t.NumFields() {
+				if err := check(t.Field(i).Type()); err != nil {
+					return err
+				}
+			}
+		case *types.Interface:
+			for i := range t.NumMethods() {
+				if err := check(t.Method(i).Type()); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return check(m.Type())
+}
+
+// generateStubs generates the method stubs with the receiver recvName of the
+// type *recvType.
+func (g *stubGenerator) generateStubs(recvName, recvType string, methods []*types.Func) string {
+	buf := &bytes.Buffer{}
+
+	for _, m := range methods {
+		sig := m.Type().(*types.Signature)
+
+		if doc, ok := g.docs[m.Pos()]; ok {
+			for _, line := range strings.Split(strings.TrimSuffix(doc, "\n"), "\n") {
+				fmt.Fprintf(buf, "// %s\n", line)
+			}
+		}
+
+		fmt.Fprintf(buf, "func (%s *%s) %s%s {\n", recvName, recvType, m.Name(), strings.TrimPrefix(types.TypeString(sig, g.qualifier), "func"))
+		fmt.Fprintf(buf, "\tpanic(\"not implemented\") // TODO: Implement\n")
+		fmt.Fprintf(buf, "}\n\n")
+	}
+
+	return buf.String()
+}
+
+// receiverName returns the lowercase initial of the instance name, or the
+// lower camel case instance name if the initial collides with a parameter of
+// any of the methods. The methods of a type share the same receiver name.
+func receiverName(instanceName string, methods []*types.Func) string {
+	initial := strings.ToLower(instanceName[:1])
+
+	for _, m := range methods {
+		sig := m.Type().(*types.Signature)
+		for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+			for i := range tuple.Len() {
+				if tuple.At(i).Name() == initial {
+					return initial + instanceName[1:]
+				}
+			}
+		}
+	}
+
+	return initial
+}
+
+// addImports adds the imports which are referred in the source but not
+// imported yet. imports maps import paths to package names.
+func (p *Project) addImports(src []byte, imports map[string]string) ([]byte, error) {
+	if len(imports) == 0 {
+		return src, nil
+	}
+
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parser: parse file: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	imported := make(map[string]bool)
+	for _, spec := range f.Imports {
+		importPath, err2 := strconv.Unquote(spec.Path.Value)
+		if err2 != nil {
+			return nil, fmt.Errorf("strconv: unquote: %w", err2)
+		}
+		imported[importPath] = true
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			used[ident.Name] = true
+		}
+		return true
+	})
+
+	changed := false
+
+	for importPath, name := range imports {
+		if imported[importPath] || !used[name] {
+			continue
+		}
+
+		if name == path.Base(importPath) {
+			astutil.AddImport(fset, f, importPath)
+		} else {
+			astutil.AddNamedImport(fset, f, name, importPath)
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return src, nil
+	}
+
+	buf := &bytes.Buffer{}
+
+	err = format.Node(buf, fset, f)
+	if err != nil {
+		return nil, fmt.Errorf("format: node: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,350 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+const stubTestPort = `package port
+
+import (
+	"context"
+	"time"
+)
+
+type Entity struct{}
+
+type options struct{}
+
+// Repository stores items.
+type Repository[T any] interface {
+	// Get returns the item.
+	Get(ctx context.Context, id string) (T, error)
+}
+
+type Clock interface {
+	Now() time.Time
+}
+
+type ClockRepository interface {
+	Clock
+	Repository[Entity]
+}
+
+type Configurer interface {
+	Configure(o options) error
+}
+
+type HealthChecker interface {
+	Check(ctx context.Context) error
+}
+
+type Checker interface {
+	Check(c context.Context) error
+}
+
+type Pinger interface {
+	Check() error
+}
+
+type UserStore interface {
+	GetUser(id string) error
+	Delete(id string) error
+}
+
+type Meter interface {
+	Reset() error
+	Measure(m int) error
+}
+`
+
+const stubTestErrorsPkg = `package errors
+
+type Reporter interface {
+	Report() error
+}
+`
+
+func TestGenerateImplementation(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		interfaceParams []string
+	}
+	type want struct {
+		err            require.ErrorAssertionFunc
+		interfaceTypes []string
+		implementation string
+		receiver       string
+		imports        map[string]string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "std interface",
+			args: args{
+				interfaceParams: []string{"io.Writer"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"io.Writer"},
+				implementation: "func (m *MyService) Write(p []byte) (n int, err error) {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{"io": "io"},
+			},
+		},
+		{
+			name: "generic port with type argument",
+			args: args{
+				interfaceParams: []string{"Repository[string]"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.Repository[string]"},
+				implementation: "// Get returns the item.\n" +
+					"func (m *MyService) Get(ctx context.Context, id string) (string, error) {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+					"context":                  "context",
+				},
+			},
+		},
+		{
+			name: "embedded interfaces",
+			args: args{
+				interfaceParams: []string{"ClockRepository"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.ClockRepository"},
+				implementation: "func (m *MyService) Now() time.Time {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n" +
+					"// Get returns the item.\n" +
+					"func (m *MyService) Get(ctx context.Context, id string) (port.Entity, error) {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+					"context":                  "context",
+					"time":                     "time",
+				},
+			},
+		},
+		{
+			name: "methods in declaration order",
+			args: args{
+				interfaceParams: []string{"UserStore"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.UserStore"},
+				implementation: "func (m *MyService) GetUser(id string) error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n" +
+					"func (m *MyService) Delete(id string) error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+				},
+			},
+		},
+		{
+			name: "multiple interfaces",
+			args: args{
+				interfaceParams: []string{"ClockRepository", "Clock", "HealthChecker"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.ClockRepository", "port.Clock", "port.HealthChecker"},
+				implementation: "func (m *MyService) Now() time.Time {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n" +
+					"// Get returns the item.\n" +
+					"func (m *MyService) Get(ctx context.Context, id string) (port.Entity, error) {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n" +
+					"func (m *MyService) Check(ctx context.Context) error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+					"context":                  "context",
+					"time":                     "time",
+				},
+			},
+		},
+		{
+			name: "same method with different parameter names",
+			args: args{
+				interfaceParams: []string{"HealthChecker", "Checker"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.HealthChecker", "port.Checker"},
+				implementation: "func (m *MyService) Check(ctx context.Context) error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+					"context":                  "context",
+				},
+			},
+		},
+		{
+			name: "receiver collides with a parameter",
+			args: args{
+				interfaceParams: []string{"Meter"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"port.Meter"},
+				implementation: "func (myService *MyService) Reset() error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n" +
+					"func (myService *MyService) Measure(m int) error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				receiver: "myService",
+				imports: map[string]string{
+					"my-project/internal/port": "port",
+				},
+			},
+		},
+		{
+			name: "package name collides with a template import",
+			args: args{
+				interfaceParams: []string{"my-project/internal/pkg/errors.Reporter"},
+			},
+			want: want{
+				err:            require.NoError,
+				interfaceTypes: []string{"errors2.Reporter"},
+				implementation: "func (m *MyService) Report() error {\n" +
+					"\tpanic(\"not implemented\") // TODO: Implement\n" +
+					"}\n\n",
+				imports: map[string]string{
+					"my-project/internal/pkg/errors": "errors2",
+				},
+			},
+		},
+		{
+			name: "conflicting methods",
+			args: args{
+				interfaceParams: []string{"HealthChecker", "Pinger"},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrCanNotImplement{})
+				},
+			},
+		},
+		{
+			name: "unexported parameter type",
+			args: args{
+				interfaceParams: []string{"Configurer"},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrCanNotImplement{})
+				},
+			},
+		},
+		{
+			name: "not existing interface",
+			args: args{
+				interfaceParams: []string{"NotExisting"},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorIs(tt, err, customerrors.ErrInterfaceNotFound{Name: "NotExisting"})
+				},
+			},
+		},
+	}
+
+	root := t.TempDir()
+
+	projectService := &Project{
+		cfg:  &config.Config{},
+		root: root,
+		fs:   filesystem.NewOS(root),
+	}
+
+	err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	err = projectService.fs.WriteFile(filepath.Join("internal", "port", "port.go"), []byte(stubTestPort), 0o644)
+	require.NoError(t, err)
+
+	err = projectService.fs.MkdirAll(filepath.Join("internal", "pkg", "errors"), 0o755)
+	require.NoError(t, err)
+
+	err = projectService.fs.WriteFile(filepath.Join("internal", "pkg", "errors", "errors.go"), []byte(stubTestErrorsPkg), 0o644)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail, err := projectService.generateImplementation(
+				context.Background(),
+				"my-project/internal/domain/core/service/myservice",
+				"core",
+				"MyService",
+				tt.args.interfaceParams,
+			)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tt.want.interfaceTypes, lo.Map(detail.Interfaces, func(i ImplementedInterface, _ int) string {
+				return i.InterfaceType
+			}))
+			require.Equal(t, tt.want.implementation, detail.Implementation)
+			require.Equal(t, lo.CoalesceOrEmpty(tt.want.receiver, "m"), detail.Receiver)
+			require.Equal(t, tt.want.imports, detail.Imports)
+		})
+	}
+}
+
+func TestAddImports(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	projectService := &Project{
+		cfg:  &config.Config{},
+		root: root,
+		fs:   filesystem.NewOS(root),
+	}
+
+	src := "package myservice\n\nimport \"io\"\n\nvar _ io.Writer = (*MyService)(nil)\n\nfunc (m *MyService) Now() time.Time {\n\tpanic(\"not implemented\")\n}\n"
+
+	content, err := projectService.addImports([]byte(src), map[string]string{
+		"io":      "io",
+		"time":    "time",
+		"strings": "strings",
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(content), "\"time\"")
+	require.NotContains(t, string(content), "\"strings\"")
+}
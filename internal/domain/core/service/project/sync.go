@@ -0,0 +1,429 @@
+package project
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+// SyncPort finds the implementers of the port and appends stubs of the methods
+// they are missing. If no port is given, all ports of the project are synced.
+//
+// A struct is an implementer if it is asserted as the port, like
+// "var _ port.X = (*T)(nil)", or returned as the port from a function, like
+// the New function of the "do" template. Methods whose signatures differ from
+// the port are reported, but not modified.
+func (p *Project) SyncPort(ctx context.Context, params model.SyncPortParams) ([]model.PortSyncResult, error) {
+	targets, err := p.syncTargets(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("sync targets: %w", err)
+	}
+
+	cfg, err := p.packagesConfig(ctx, packages.NeedName|packages.NeedFiles|packages.NeedImports|packages.NeedDeps|
+		packages.NeedTypes|packages.NeedSyntax|packages.NeedTypesInfo)
+	if err != nil {
+		return nil, fmt.Errorf("packages config: %w", err)
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("packages: load: %w", err)
+	}
+
+	// type errors are expected, since the implementers lack the methods of
+	// the ports, but the implementers in a package which can not be listed
+	// or parsed would be missed
+	for _, pkg := range pkgs {
+		for _, pkgErr := range pkg.Errors {
+			if pkgErr.Kind != packages.TypeError {
+				return nil, fmt.Errorf("packages: load: %s", pkgErr.Error())
+			}
+		}
+	}
+
+	allPkgs := make(map[string]*packages.Package)
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		allPkgs[pkg.PkgPath] = pkg
+	})
+
+	rootPkgs := make(map[string]*packages.Package, len(pkgs))
+	for _, pkg := range pkgs {
+		rootPkgs[pkg.PkgPath] = pkg
+	}
+
+	implementers := p.findImplementers(pkgs, rootPkgs, targets)
+
+	results, err := p.syncImplementers(implementers, allPkgs)
+	if err != nil {
+		return nil, fmt.Errorf("sync implementers: %w", err)
+	}
+
+	return results, nil
+}
+
+// syncTargets returns the ports to sync as "<import path>.<name>" keys.
+func (p *Project) syncTargets(ctx context.Context, params model.SyncPortParams) (map[string]bool, error) {
+	targets := make(map[string]bool)
+
+	if params.PortParam != "" {
+		interfaceInfo, err := p.getInterfaceInfo(ctx, params.PortParam, params.TargetDomain)
+		if err != nil {
+			return nil, fmt.Errorf("get interface info: %w", err)
+		}
+
+		targets[interfaceInfo.ImportPath+"."+interfaceInfo.InterfaceName] = true
+
+		return targets, nil
+	}
+
+	ports, err := p.GetAllPorts(ctx, params.TargetDomain)
+	if err != nil {
+		return nil, fmt.Errorf("get all ports: %w", err)
+	}
+
+	for _, port := range ports {
+		targets[port.ImportPath+"."+port.Name] = true
+	}
+
+	return targets, nil
+}
+
+type implementer struct {
+	pkg   *packages.Package
+	named *types.Named
+	port  *types.Named
+}
+
+func (p *Project) findImplementers(pkgs []*packages.Package, rootPkgs map[string]*packages.Package, targets map[string]bool) []implementer {
+	implementers := make([]implementer, 0)
+	seen := make(map[string]bool)
+
+	isTarget := func(t types.Type) (*types.Named, bool) {
+		named, ok := types.Unalias(t).(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return nil, false
+		}
+		origin := named.Origin().Obj()
+		return named, targets[origin.Pkg().Path()+"."+origin.Name()]
+	}
+
+	add := func(port *types.Named, t types.Type) {
+		if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+
+		named, ok := types.Unalias(t).(*types.Named)
+		if !ok || named.Obj().Pkg() == nil || types.IsInterface(named) {
+			return
+		}
+
+		pkg, ok := rootPkgs[named.Obj().Pkg().Path()]
+		if !ok {
+			return
+		}
+
+		key := types.TypeString(named, nil) + "|" + types.TypeString(port, nil)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		implementers = append(implementers, implementer{
+			pkg:   pkg,
+			named: named,
+			port:  port,
+		})
+	}
+
+	for _, pkg := range pkgs {
+		info := pkg.TypesInfo
+		if info == nil {
+			continue
+		}
+
+		var visit func(n ast.Node, results *types.Tuple) bool
+		visit = func(n ast.Node, results *types.Tuple) bool {
+			switch n := n.(type) {
+			case *ast.ValueSpec:
+				if n.Type == nil {
+					return true
+				}
+				if port, ok := isTarget(info.TypeOf(n.Type)); ok {
+					for _, value := range n.Values {
+						add(port, info.TypeOf(value))
+					}
+				}
+			case *ast.FuncDecl:
+				if n.Body != nil {
+					if obj, ok := info.Defs[n.Name].(*types.Func); ok {
+						sig := obj.Type().(*types.Signature)
+						ast.Inspect(n.Body, func(c ast.Node) bool {
+							return visit(c, sig.Results())
+						})
+					}
+				}
+				return false
+			case *ast.FuncLit:
+				if sig, ok := info.TypeOf(n).(*types.Signature); ok {
+					ast.Inspect(n.Body, func(c ast.Node) bool {
+						return visit(c, sig.Results())
+					})
+				}
+				return false
+			case *ast.ReturnStmt:
+				if results == nil || len(n.Results) != results.Len() {
+					return true
+				}
+				for i, expr := range n.Results {
+					if port, ok := isTarget(results.At(i).Type()); ok {
+						add(port, info.TypeOf(expr))
+					}
+				}
+			}
+			return true
+		}
+
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(n ast.Node) bool {
+				return visit(n, nil)
+			})
+		}
+	}
+
+	return implementers
+}
+
+// syncFile is a file which the stubs of the missing methods are appended to.
+type syncFile struct {
+	relPath  string
+	pkg      *packages.Package
+	portPkgs []*packages.Package
+	structs  []*syncStruct
+}
+
+// syncStruct is an implementer with the missing methods of all of its ports.
+// A method which is declared by more than one port is added once.
+type syncStruct struct {
+	impl    implementer
+	methods []*types.Func
+	ports   map[string]string // method name -> port which declares it
+}
+
+// syncImplementers reports the missing and the drifted methods of each
+// implementer and port pair. The missing methods are grouped by the file, so
+// each file is written once, even if its structs implement more than one port.
+func (p *Project) syncImplementers(implementers []implementer, allPkgs map[string]*packages.Package) ([]model.PortSyncResult, error) {
+	results := make([]model.PortSyncResult, 0, len(implementers))
+	files := make([]*syncFile, 0)
+
+	for _, impl := range implementers {
+		result, missing, err := p.diffImplementer(impl)
+		if err != nil {
+			return nil, fmt.Errorf("diff implementer: %w", err)
+		}
+
+		result.AddedMethods = lo.Map(missing, func(m *types.Func, _ int) string {
+			return m.Name()
+		})
+
+		results = append(results, *result)
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		file, ok := lo.Find(files, func(f *syncFile) bool {
+			return f.relPath == result.File
+		})
+		if !ok {
+			file = &syncFile{relPath: result.File, pkg: impl.pkg}
+			files = append(files, file)
+		}
+
+		if portPkg, ok := allPkgs[impl.port.Obj().Pkg().Path()]; ok && !slices.Contains(file.portPkgs, portPkg) {
+			file.portPkgs = append(file.portPkgs, portPkg)
+		}
+
+		st, ok := lo.Find(file.structs, func(st *syncStruct) bool {
+			return st.impl.named.Origin() == impl.named.Origin()
+		})
+		if !ok {
+			st = &syncStruct{impl: impl, ports: make(map[string]string)}
+			file.structs = append(file.structs, st)
+		}
+
+		for _, m := range missing {
+			added, ok := lo.Find(st.methods, func(added *types.Func) bool {
+				return added.Name() == m.Name()
+			})
+			if !ok {
+				st.methods = append(st.methods, m)
+				st.ports[m.Name()] = result.Port
+				continue
+			}
+
+			if !types.Identical(added.Type(), m.Type()) {
+				return nil, customerrors.ErrCanNotImplement{
+					Interface: result.Port,
+					Reason:    fmt.Sprintf("method %s conflicts with the one of %s", m.Name(), st.ports[m.Name()]),
+				}
+			}
+		}
+	}
+
+	for _, file := range files {
+		err := p.writeStubs(file)
+		if err != nil {
+			return nil, fmt.Errorf("write stubs: %w", err)
+		}
+	}
+
+	return results, nil
+}
+
+// diffImplementer returns the result of the implementer and port pair and the
+// methods of the port which the implementer is missing.
+func (p *Project) diffImplementer(impl implementer) (*model.PortSyncResult, []*types.Func, error) {
+	iface := impl.port.Underlying().(*types.Interface)
+
+	filePath := impl.pkg.Fset.Position(impl.named.Obj().Pos()).Filename
+
+	relPath, err := filepath.Rel(p.root, filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filepath: rel: %w", err)
+	}
+
+	result := &model.PortSyncResult{
+		Port:           types.TypeString(impl.port, (*types.Package).Name),
+		Implementer:    impl.named.Obj().Name(),
+		File:           relPath,
+		DriftedMethods: make([]model.MethodDrift, 0),
+	}
+
+	g := newStubGenerator(impl.pkg.PkgPath)
+
+	methodSet := types.NewMethodSet(types.NewPointer(impl.named))
+	missing := make([]*types.Func, 0)
+
+	for _, m := range g.collectMethods(iface) {
+		sel := methodSet.Lookup(m.Pkg(), m.Name())
+		if sel == nil {
+			missing = append(missing, m)
+			continue
+		}
+
+		if !types.Identical(sel.Obj().Type(), m.Type()) {
+			qf := types.RelativeTo(impl.pkg.Types)
+			result.DriftedMethods = append(result.DriftedMethods, model.MethodDrift{
+				Name: m.Name(),
+				Have: strings.TrimPrefix(types.TypeString(sel.Obj().Type(), qf), "func"),
+				Want: strings.TrimPrefix(types.TypeString(m.Type(), qf), "func"),
+			})
+		}
+	}
+
+	return result, missing, nil
+}
+
+// writeStubs appends the stubs of the missing methods of the structs to the
+// file.
+func (p *Project) writeStubs(file *syncFile) error {
+	g := newStubGenerator(file.pkg.PkgPath, file.portPkgs...)
+
+	buf := &bytes.Buffer{}
+
+	for _, st := range file.structs {
+		for _, m := range st.methods {
+			err := g.checkAccessible(m)
+			if err != nil {
+				return customerrors.ErrCanNotImplement{Interface: st.ports[m.Name()], Reason: err.Error()}
+			}
+		}
+
+		recvName := cmp.Or(p.existingReceiverName(st.impl), receiverName(st.impl.named.Obj().Name(), st.methods))
+
+		buf.WriteString("\n")
+		buf.WriteString(g.generateStubs(recvName, p.receiverType(st.impl.named), st.methods))
+	}
+
+	src, err := p.fs.ReadFile(file.relPath)
+	if err != nil {
+		return fmt.Errorf("fs: read file: %w", err)
+	}
+
+	content, err := p.addImports(append(src, buf.Bytes()...), g.imports)
+	if err != nil {
+		return fmt.Errorf("add imports: %w", err)
+	}
+
+	content, err = format.Source(content)
+	if err != nil {
+		return fmt.Errorf("format: source: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	err = p.fs.WriteFile(file.relPath, content, 0o600)
+	if err != nil {
+		return fmt.Errorf("fs: write file: %w", err)
+	}
+
+	return nil
+}
+
+// receiverType returns the name of the type with its type parameters, like
+// Repo[K, V], since the methods of a generic type can not be declared on an
+// instance of it.
+func (*Project) receiverType(named *types.Named) string {
+	tparams := named.Origin().TypeParams()
+	if tparams.Len() == 0 {
+		return named.Obj().Name()
+	}
+
+	names := make([]string, 0, tparams.Len())
+	for i := range tparams.Len() {
+		names = append(names, tparams.At(i).Obj().Name())
+	}
+
+	return named.Obj().Name() + "[" + strings.Join(names, ", ") + "]"
+}
+
+// existingReceiverName returns the receiver name used by the existing methods
+// of the implementer, so that generated stubs look like the rest of the file.
+func (*Project) existingReceiverName(impl implementer) string {
+	for _, f := range impl.pkg.Syntax {
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+				continue
+			}
+
+			field := funcDecl.Recv.List[0]
+			if len(field.Names) == 0 || field.Names[0].Name == "_" {
+				continue
+			}
+
+			recvType := impl.pkg.TypesInfo.TypeOf(field.Type)
+			if ptr, ok := recvType.(*types.Pointer); ok {
+				recvType = ptr.Elem()
+			}
+
+			if named, ok := recvType.(*types.Named); ok && named.Origin() == impl.named.Origin() {
+				return field.Names[0].Name
+			}
+		}
+	}
+
+	return ""
+}
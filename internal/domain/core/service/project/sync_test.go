@@ -0,0 +1,345 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+const syncTestPort = `package port
+
+import "context"
+
+type UserRepository interface {
+	GetUser(ctx context.Context, id string) (string, error)
+	DeleteUser(ctx context.Context, id string) error
+}
+
+type UserDeleter interface {
+	DeleteUser(ctx context.Context, id string) error
+}
+
+type LegacyUserDeleter interface {
+	DeleteUser(id string) error
+}
+
+type Repository[T any] interface {
+	Get(id string) (T, error)
+	Delete(id string) error
+}
+`
+
+func TestSyncPort(t *testing.T) {
+	t.Parallel()
+
+	type in struct {
+		files     map[string]string
+		portParam string
+	}
+	type want struct {
+		err      require.ErrorAssertionFunc
+		results  []model.PortSyncResult
+		contains map[string]string
+	}
+
+	tests := []struct {
+		name string
+		in
+		want
+	}{
+		{
+			name: "asserted implementer",
+			in: in{
+				portParam: "UserRepository",
+				files: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": `package userrepo
+
+import (
+	"context"
+
+	"my-project/internal/port"
+)
+
+var _ port.UserRepository = (*UserRepo)(nil)
+
+type UserRepo struct{}
+
+func (r *UserRepo) GetUser(ctx context.Context, id string) (string, error) {
+	return "", nil
+}
+`,
+				},
+			},
+			want: want{
+				err: require.NoError,
+				results: []model.PortSyncResult{
+					{
+						Port:           "port.UserRepository",
+						Implementer:    "UserRepo",
+						File:           filepath.Join("internal", "infrastructure", "userrepo", "userrepo.go"),
+						AddedMethods:   []string{"DeleteUser"},
+						DriftedMethods: []model.MethodDrift{},
+					},
+				},
+				contains: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": "func (r *UserRepo) DeleteUser(ctx context.Context, id string) error {",
+				},
+			},
+		},
+		{
+			name: "returned implementer with drifted method",
+			in: in{
+				portParam: "UserRepository",
+				files: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": `package userrepo
+
+import "my-project/internal/port"
+
+type UserRepo struct{}
+
+func New() (port.UserRepository, error) {
+	return &UserRepo{}, nil
+}
+
+func (r *UserRepo) GetUser(id string) (string, error) {
+	return "", nil
+}
+`,
+				},
+			},
+			want: want{
+				err: require.NoError,
+				results: []model.PortSyncResult{
+					{
+						Port:         "port.UserRepository",
+						Implementer:  "UserRepo",
+						File:         filepath.Join("internal", "infrastructure", "userrepo", "userrepo.go"),
+						AddedMethods: []string{"DeleteUser"},
+						DriftedMethods: []model.MethodDrift{
+							{
+								Name: "GetUser",
+								Have: "(id string) (string, error)",
+								Want: "(ctx context.Context, id string) (string, error)",
+							},
+						},
+					},
+				},
+				contains: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": "import (\n\t\"context\"\n",
+				},
+			},
+		},
+		{
+			name: "user of the port is not an implementer",
+			in: in{
+				portParam: "UserRepository",
+				files: map[string]string{
+					"internal/domain/core/service/user/user.go": `package user
+
+import (
+	"context"
+
+	"my-project/internal/port"
+)
+
+type User struct {
+	repo port.UserRepository
+}
+
+func (u *User) GetUser(ctx context.Context, id string) (string, error) {
+	return u.repo.GetUser(ctx, id)
+}
+`,
+				},
+			},
+			want: want{
+				err:     require.NoError,
+				results: []model.PortSyncResult{},
+			},
+		},
+		{
+			name: "method of more than one port is added once",
+			in: in{
+				files: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": `package userrepo
+
+import (
+	"context"
+
+	"my-project/internal/port"
+)
+
+var (
+	_ port.UserRepository = (*UserRepo)(nil)
+	_ port.UserDeleter    = (*UserRepo)(nil)
+)
+
+type UserRepo struct{}
+
+func (r *UserRepo) GetUser(ctx context.Context, id string) (string, error) {
+	return "", nil
+}
+`,
+				},
+			},
+			want: want{
+				err: require.NoError,
+				results: []model.PortSyncResult{
+					{
+						Port:           "port.UserRepository",
+						Implementer:    "UserRepo",
+						File:           filepath.Join("internal", "infrastructure", "userrepo", "userrepo.go"),
+						AddedMethods:   []string{"DeleteUser"},
+						DriftedMethods: []model.MethodDrift{},
+					},
+					{
+						Port:           "port.UserDeleter",
+						Implementer:    "UserRepo",
+						File:           filepath.Join("internal", "infrastructure", "userrepo", "userrepo.go"),
+						AddedMethods:   []string{"DeleteUser"},
+						DriftedMethods: []model.MethodDrift{},
+					},
+				},
+				contains: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": "func (r *UserRepo) DeleteUser(ctx context.Context, id string) error {",
+				},
+			},
+		},
+		{
+			name: "generic implementer",
+			in: in{
+				portParam: "Repository",
+				files: map[string]string{
+					"internal/infrastructure/repo/repo.go": `package repo
+
+import "my-project/internal/port"
+
+var _ port.Repository[int] = (*Repo[int])(nil)
+
+type Repo[T any] struct{}
+
+func (r *Repo[T]) Get(id string) (T, error) {
+	var zero T
+	return zero, nil
+}
+`,
+				},
+			},
+			want: want{
+				err: require.NoError,
+				results: []model.PortSyncResult{
+					{
+						Port:           "port.Repository[int]",
+						Implementer:    "Repo",
+						File:           filepath.Join("internal", "infrastructure", "repo", "repo.go"),
+						AddedMethods:   []string{"Delete"},
+						DriftedMethods: []model.MethodDrift{},
+					},
+				},
+				contains: map[string]string{
+					"internal/infrastructure/repo/repo.go": "func (r *Repo[T]) Delete(id string) error {",
+				},
+			},
+		},
+		{
+			name: "package which can not be parsed",
+			in: in{
+				portParam: "UserRepository",
+				files: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": `package userrepo
+
+import "my-project/internal/port"
+
+var _ port.UserRepository = (*UserRepo)(nil)
+
+type UserRepo struct{}
+
+func (r *UserRepo) GetUser(
+`,
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorContains(tt, err, "packages: load")
+				},
+				contains: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": "func (r *UserRepo) GetUser(\n",
+				},
+			},
+		},
+		{
+			name: "conflicting methods of ports",
+			in: in{
+				files: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": `package userrepo
+
+import "my-project/internal/port"
+
+var (
+	_ port.UserDeleter       = (*UserRepo)(nil)
+	_ port.LegacyUserDeleter = (*UserRepo)(nil)
+)
+
+type UserRepo struct{}
+`,
+				},
+			},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrCanNotImplement{})
+				},
+				contains: map[string]string{
+					"internal/infrastructure/userrepo/userrepo.go": "type UserRepo struct{}\n",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+
+			projectService := &Project{
+				cfg:  &config.Config{},
+				root: root,
+				fs:   filesystem.NewOS(root),
+			}
+
+			err := projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			tt.in.files["internal/port/user.go"] = syncTestPort
+
+			for name, content := range tt.in.files {
+				require.NoError(t, projectService.fs.MkdirAll(filepath.Dir(name), 0o755))
+				require.NoError(t, projectService.fs.WriteFile(name, []byte(content), 0o644))
+			}
+
+			results, err := projectService.SyncPort(context.Background(), model.SyncPortParams{
+				PortParam: tt.in.portParam,
+			})
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.results, results)
+
+			for name, substr := range tt.want.contains {
+				content, err := projectService.fs.ReadFile(name)
+				require.NoError(t, err)
+				require.Equal(t, 1, strings.Count(string(content), substr), string(content))
+			}
+		})
+	}
+}
@@ -5,17 +5,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
-	"os"
-	"os/exec"
+	"go/format"
+	"io/fs"
+	"maps"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"text/template"
 
-	"github.com/samber/lo"
-
 	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/pkg/version"
 )
 
 type TemplateType string
@@ -25,184 +26,314 @@ const (
 	TTApplication TemplateType = "application"
 	TTPackage     TemplateType = "package"
 	TTInfra       TemplateType = "infra"
+	TTCmd         TemplateType = "cmd"
 )
 
-func (p *Project) generateGoInitFile(ctx context.Context, dir, structName, pkgName, portParam string, tt TemplateType, assertInterface bool) (string, error) {
-	targetFilePath := filepath.Join(dir, fmt.Sprintf("%s.go", pkgName))
+// TemplateData is the data which the component templates are executed with.
+type TemplateData struct {
+	StructName      string                 // struct name of the instance, e.g. UserService
+	PkgName         string                 // package name of the generated file, e.g. userservice
+	Receiver        string                 // receiver name of the methods, e.g. u
+	Kind            string                 // kind of the component, e.g. service
+	Domain          string                 // target domain, empty for the components out of a domain
+	EntryPoint      string                 // name of the entry point, e.g. api, only for cmd templates
+	ModulePath      string                 // module path of the project
+	PkgPath         string                 // import path of the generated package
+	Implementation  string                 // generated method stubs of the interfaces
+	ImportPath      string                 // import path of the first implemented interface
+	ImportName      string                 // package name which the first interface is referred with
+	InterfaceName   string                 // name of the first implemented interface, e.g. Repository
+	InterfaceType   string                 // qualified type of the first implemented interface, e.g. port.Repository[string]
+	Interfaces      []ImplementedInterface // all implemented interfaces
+	AssertInterface bool                   // whether the interfaces should be asserted
+	Dependencies    []Dependency           // ports which the component depends on
+	HexagoVersion   string                 // version of hexago which generated the file
+}
 
-	implementationDetails, err := p.generateImplementation(ctx, structName, portParam)
+// generateComponentFiles renders the files of the component template into
+// dir and returns the path of the main file, which is <pkgName>.go, or main.go
+// for the entry points. All files are rendered and formatted before any of
+// them is written. portParams are the ports which the component implements,
+// and dependencyParams are the ones which it depends on.
+func (p *Project) generateComponentFiles(ctx context.Context, dir, targetDomain, structName, pkgName string, portParams []string, tt TemplateType, assertInterface bool, dependencyParams []string) (string, error) {
+	moduleName, err := p.GetModuleName()
 	if err != nil {
-		return "", fmt.Errorf("generate implementation: %w", err)
+		return "", fmt.Errorf("get module name: %w", err)
 	}
 
-	var templateMode string
+	targetPkgPath := path.Join(moduleName, filepath.ToSlash(dir))
 
-	switch tt {
-	case TTService:
-		templateMode = p.cfg.GetServiceTemplate()
-	case TTApplication:
-		templateMode = p.cfg.GetApplicationTemplate()
-	case TTInfra:
-		templateMode = p.cfg.GetInfrastructureTemplate()
-	case TTPackage:
-		templateMode = p.cfg.GetPackageTemplate()
-	default:
-		return "", fmt.Errorf("invalid template type: %s", string(tt))
+	implementationDetails, err := p.generateImplementation(ctx, targetPkgPath, targetDomain, structName, portParams)
+	if err != nil {
+		return "", fmt.Errorf("generate implementation: %w", err)
 	}
 
-	packageTemplate, err := p.parseTemplate(templateMode, string(tt))
+	templateMode, err := p.templateMode(tt)
+	if err != nil {
+		return "", fmt.Errorf("template mode: %w", err)
+	}
+
+	templateFiles, err := p.parseTemplate(templateMode, tt)
 	if err != nil {
 		return "", fmt.Errorf("parse template: %w", err)
 	}
 
-	var (
-		implementation string
-		importPath     string
-		interfaceName  string
-	)
+	data := TemplateData{
+		StructName:      structName,
+		PkgName:         pkgName,
+		Receiver:        receiverName(structName, nil),
+		Kind:            string(tt),
+		Domain:          targetDomain,
+		ModulePath:      moduleName,
+		PkgPath:         targetPkgPath,
+		AssertInterface: assertInterface,
+		HexagoVersion:   version.Version,
+	}
+
+	if tt == TTCmd {
+		data.EntryPoint = filepath.Base(dir)
+	}
+
+	var imports map[string]string
 
 	if implementationDetails != nil {
-		implementation = implementationDetails.Implementation
-		importPath = implementationDetails.ImportPath
-		interfaceName = implementationDetails.InterfaceName
+		data.Implementation = implementationDetails.Implementation
+		data.Receiver = implementationDetails.Receiver
+		data.Interfaces = implementationDetails.Interfaces
+		imports = implementationDetails.Imports
+
+		// the first interface is kept in the flat fields, so the templates
+		// written for a single port keep working
+		if len(data.Interfaces) > 0 {
+			data.ImportPath = data.Interfaces[0].ImportPath
+			data.ImportName = data.Interfaces[0].ImportName
+			data.InterfaceName = data.Interfaces[0].InterfaceName
+			data.InterfaceType = data.Interfaces[0].InterfaceType
+		}
 	}
 
-	buf := &bytes.Buffer{}
-	err = packageTemplate.Execute(buf, map[string]any{
-		"StructName":      structName,
-		"PkgName":         pkgName,
-		"Implementation":  implementation,
-		"ImportPath":      importPath,
-		"InterfaceName":   interfaceName,
-		"AssertInterface": assertInterface,
-	})
+	data.Dependencies, imports, err = p.resolveDependencies(ctx, targetPkgPath, targetDomain, dependencyParams, imports)
 	if err != nil {
-		return "", fmt.Errorf("template: execute: %w", customerrors.ErrTemplateCanNotExecute{Message: err.Error()})
+		return "", fmt.Errorf("resolve dependencies: %w", err)
 	}
 
-	err = os.WriteFile(targetFilePath, buf.Bytes(), 0o600)
+	files, err := p.renderTemplateFiles(templateFiles, data, imports)
 	if err != nil {
-		return "", fmt.Errorf("os: write file: %w", err)
+		return "", fmt.Errorf("render template files: %w", err)
 	}
 
-	return targetFilePath, nil
-}
+	mainFile := filepath.Join(dir, fmt.Sprintf("%s.go", pkgName))
+	if _, ok := files[filepath.Base(mainFile)]; !ok {
+		return "", fmt.Errorf("template: %w", customerrors.ErrTemplateCanNotExecute{Message: fmt.Sprintf("%s.go is not generated", pkgName)})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		filePath := filepath.Join(dir, name)
 
-func (p *Project) parseTemplate(templateMode, templateName string) (*template.Template, error) {
-	switch templateMode {
-	case "std", "do":
-		f, err := assets.ReadFile(fmt.Sprintf("assets/templates/%s_%s.tmpl", templateMode, templateName))
+		err = p.fs.MkdirAll(filepath.Dir(filePath), 0o755)
 		if err != nil {
-			return nil, fmt.Errorf("assets: read file: %w", err)
+			return "", fmt.Errorf("fs: mkdir all: %w", err)
 		}
 
-		tmpl, err := template.New("").Parse(string(f))
+		err = p.fs.WriteFile(filePath, files[name], 0o600)
 		if err != nil {
-			return nil, fmt.Errorf("template: parse: %w", err)
+			return "", fmt.Errorf("fs: write file: %w", err)
 		}
+	}
 
-		return tmpl, nil
+	return mainFile, nil
+}
+
+// templateMode returns the template mode configured for the template type.
+func (p *Project) templateMode(tt TemplateType) (string, error) {
+	switch tt {
+	case TTService:
+		return p.cfg.GetServiceTemplate(), nil
+	case TTApplication:
+		return p.cfg.GetApplicationTemplate(), nil
+	case TTInfra:
+		return p.cfg.GetInfrastructureTemplate(), nil
+	case TTPackage:
+		return p.cfg.GetPackageTemplate(), nil
+	case TTCmd:
+		return p.cfg.GetEntryPointTemplate(), nil
 	default:
-		templatePath := filepath.Join(".hexago", "templates", fmt.Sprintf("%s_%s.tmpl", templateMode, templateName))
+		if k, ok := p.cfg.GetKinds()[string(tt)]; ok {
+			return k.Template, nil
+		}
+		return "", customerrors.ErrInvalidTemplateKind{Kind: string(tt)}
+	}
+}
 
-		tmpl, err := template.ParseFiles(templatePath)
+// renderTemplateFiles executes the template files and returns their contents
+// by their paths relative to the component directory. Go files are formatted
+// and the imports required by the generated stubs are added to them.
+func (p *Project) renderTemplateFiles(templateFiles []templateFile, data TemplateData, imports map[string]string) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(templateFiles))
+
+	for _, tf := range templateFiles {
+		nameBuf := &bytes.Buffer{}
+		err := tf.name.Execute(nameBuf, data)
 		if err != nil {
-			return nil, fmt.Errorf("template: parse files: %w", errors.Join(err, customerrors.ErrTemplateCanNotParsed))
+			return nil, fmt.Errorf("template: execute: %w", customerrors.ErrTemplateCanNotExecute{Message: err.Error()})
 		}
 
-		return tmpl, nil
-	}
-}
+		// a file whose name is rendered empty is skipped, so files can be
+		// generated conditionally
+		if strings.TrimSpace(nameBuf.String()) == "" {
+			continue
+		}
 
-var rgxInterfaces = regexp.MustCompile(`(?m)^type\s([A-Z][a-zA-Z0-9]+)\sinterface`)
+		name := filepath.FromSlash(strings.TrimSuffix(nameBuf.String(), ".tmpl"))
+		if !filepath.IsLocal(name) {
+			return nil, customerrors.ErrTemplateCanNotExecute{Message: fmt.Sprintf("invalid file name: %q", nameBuf.String())}
+		}
+		name = filepath.Clean(name)
 
-func (p *Project) parseInterfaces(interfaceFile string) ([]string, error) {
-	f, err := os.Open(interfaceFile)
-	if err != nil {
-		return nil, fmt.Errorf("os: open: %w", err)
-	}
-	defer func() {
-		err2 := f.Close()
-		if err2 != nil {
-			fmt.Println(err2)
+		if _, ok := files[name]; ok {
+			return nil, customerrors.ErrTemplateCanNotExecute{Message: fmt.Sprintf("file is generated twice: %s", name)}
 		}
-	}()
 
-	buf := &bytes.Buffer{}
+		buf := &bytes.Buffer{}
+		err = tf.content.Execute(buf, data)
+		if err != nil {
+			return nil, fmt.Errorf("template: execute: %w", customerrors.ErrTemplateCanNotExecute{Message: err.Error()})
+		}
 
-	_, err = io.Copy(buf, f)
-	if err != nil {
-		return nil, fmt.Errorf("io: copy: %w", err)
-	}
+		content := buf.Bytes()
 
-	submatches := rgxInterfaces.FindAllSubmatch(buf.Bytes(), -1)
+		if filepath.Ext(name) == ".go" {
+			content, err = p.addImports(content, imports)
+			if err != nil {
+				return nil, fmt.Errorf("add imports: %w", err)
+			}
 
-	interfaces := lo.Map(submatches, func(m [][]byte, _ int) string {
-		return string(m[1])
-	})
+			content, err = format.Source(content)
+			if err != nil {
+				return nil, fmt.Errorf("format: source: %w", customerrors.ErrFormatGoFile{Message: fmt.Sprintf("%s: %s", name, err)})
+			}
+		}
 
-	return interfaces, nil
-}
+		files[name] = content
+	}
 
-type PortValue struct {
-	Name   string
-	Domain string
+	return files, nil
 }
 
-type ImplementationDetail struct {
-	InterfaceName  string
-	ImportPath     string
-	Implementation string
+// templateFile is a file of a component template. Both the path of the file
+// and its content are templates.
+type templateFile struct {
+	name    *template.Template
+	content *template.Template
 }
 
-func (p *Project) generateImplementation(ctx context.Context, instanceName, interfaceParam string) (*ImplementationDetail, error) {
-	if interfaceParam == "" {
-		return nil, nil
+// parseTemplate parses the files of the template mode for the kind. A custom
+// template is either a single .hexago/templates/<mode>_<kind>.tmpl file which
+// becomes <pkg>.go, or a .hexago/templates/<mode>/<kind>/ directory whose
+// files are all rendered.
+func (p *Project) parseTemplate(templateMode string, tt TemplateType) ([]templateFile, error) {
+	templateName := string(tt)
+
+	switch {
+	case slices.Contains(builtInTemplateModes(tt), templateMode):
+		f, err := assets.ReadFile(builtInTemplateFile(templateMode, tt))
+		if err != nil {
+			return nil, fmt.Errorf("assets: read file: %w", err)
+		}
+
+		tf, err := parseTemplateFile("{{.PkgName}}.go", f)
+		if err != nil {
+			return nil, fmt.Errorf("parse template file: %w", err)
+		}
+
+		return []templateFile{tf}, nil
+	default:
+		templateDir := filepath.Join(".hexago", "templates", templateMode, templateName)
+
+		if info, err := p.fs.Stat(templateDir); err == nil && info.IsDir() {
+			return p.parseTemplateDir(templateDir)
+		}
+
+		templatePath := filepath.Join(".hexago", "templates", fmt.Sprintf("%s_%s.tmpl", templateMode, templateName))
+
+		content, err := p.fs.ReadFile(templatePath)
+		if err != nil {
+			return nil, fmt.Errorf("fs: read file: %w", errors.Join(err, customerrors.ErrTemplateCanNotParsed))
+		}
+
+		tf, err := parseTemplateFile("{{.PkgName}}.go", content)
+		if err != nil {
+			return nil, fmt.Errorf("parse template file: %w", errors.Join(err, customerrors.ErrTemplateCanNotParsed))
+		}
+
+		return []templateFile{tf}, nil
 	}
+}
+
+func (p *Project) parseTemplateDir(templateDir string) ([]templateFile, error) {
+	templateFiles := make([]templateFile, 0)
+
+	err := fs.WalkDir(p.fs, filepath.ToSlash(templateDir), func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		content, err := p.fs.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("fs: read file: %w", err)
+		}
+
+		name := strings.TrimPrefix(filePath, filepath.ToSlash(templateDir)+"/")
+
+		tf, err := parseTemplateFile(name, content)
+		if err != nil {
+			return fmt.Errorf("parse template file: %s: %w", name, err)
+		}
+
+		templateFiles = append(templateFiles, tf)
 
-	interfaceInfo, err := p.getInterfaceInfo(ctx, interfaceParam)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("get interface info: %w", err)
+		return nil, fmt.Errorf("fs: walk dir: %w", errors.Join(err, customerrors.ErrTemplateCanNotParsed))
 	}
 
-	if interfaceInfo == nil {
-		return nil, nil
+	if len(templateFiles) == 0 {
+		return nil, fmt.Errorf("template dir is empty: %s: %w", templateDir, customerrors.ErrTemplateCanNotParsed)
 	}
 
-	cmd := exec.Command(
-		"impl",
-		fmt.Sprintf("%s *%s", strings.ToLower(string(instanceName[0])), instanceName),
-		interfaceInfo.ImplementParam,
-	)
+	return templateFiles, nil
+}
 
-	stdOut, stdErr := &bytes.Buffer{}, &bytes.Buffer{}
-	cmd.Stdout, cmd.Stderr = stdOut, stdErr
+func parseTemplateFile(name string, content []byte) (templateFile, error) {
+	nameTmpl, err := template.New("name").Funcs(templateFuncs).Parse(name)
+	if err != nil {
+		return templateFile{}, fmt.Errorf("template: parse: %w", err)
+	}
 
-	err = cmd.Run()
+	contentTmpl, err := template.New(name).Funcs(templateFuncs).Parse(string(content))
 	if err != nil {
-		return nil, fmt.Errorf("impl: %s", stdErr.String())
+		return templateFile{}, fmt.Errorf("template: parse: %w", err)
 	}
 
-	return &ImplementationDetail{
-		InterfaceName:  interfaceInfo.InterfaceName,
-		ImportPath:     interfaceInfo.ImportPath,
-		Implementation: stdOut.String(),
-	}, nil
+	return templateFile{name: nameTmpl, content: contentTmpl}, nil
 }
 
 var (
-	rgxPortParam   = regexp.MustCompile(`^([A-Z]\w*)$`)
-	rgxNormalParam = regexp.MustCompile(`^(\S+)\.([A-Z]\w*)$`)
+	rgxPortParam   = regexp.MustCompile(`^([A-Z]\w*)(\[.+\])?$`)
+	rgxNormalParam = regexp.MustCompile(`^([^\s\[\]]+)\.([A-Z]\w*)(\[.+\])?$`)
 )
 
 type InterfaceInfo struct {
-	InterfaceName  string
-	ImplementParam string
-	ImportPath     string
-	IsInDomain     bool
+	InterfaceName string
+	TypeArgs      string
+	ImportPath    string
 }
 
-func (p *Project) getInterfaceInfo(_ context.Context, interfaceParam string) (*InterfaceInfo, error) {
+func (p *Project) getInterfaceInfo(ctx context.Context, interfaceParam, targetDomain string) (*InterfaceInfo, error) {
 	isPortParam := rgxPortParam.MatchString(interfaceParam)
 	isNormalParam := rgxNormalParam.MatchString(interfaceParam)
 
@@ -213,46 +344,22 @@ func (p *Project) getInterfaceInfo(_ context.Context, interfaceParam string) (*I
 	if isNormalParam {
 		sm := rgxNormalParam.FindStringSubmatch(interfaceParam)
 		return &InterfaceInfo{
-			InterfaceName:  sm[2],
-			ImplementParam: interfaceParam,
-			ImportPath:     sm[1],
+			InterfaceName: sm[2],
+			TypeArgs:      sm[3],
+			ImportPath:    sm[1],
 		}, nil
 	}
 
-	moduleName, err := p.GetModuleName()
+	sm := rgxPortParam.FindStringSubmatch(interfaceParam)
+
+	port, err := p.findPort(ctx, sm[1], targetDomain)
 	if err != nil {
-		return nil, fmt.Errorf("get module name: %w", err)
+		return nil, fmt.Errorf("find port: %w", err)
 	}
 
-	interfacePath := filepath.Join(moduleName, "internal", "port")
-
 	return &InterfaceInfo{
-		InterfaceName:  interfaceParam,
-		ImplementParam: fmt.Sprintf("%s.%s", interfacePath, interfaceParam),
-		ImportPath:     interfacePath,
-		IsInDomain:     true,
+		InterfaceName: sm[1],
+		TypeArgs:      sm[2],
+		ImportPath:    port.ImportPath,
 	}, nil
 }
-
-func (p *Project) formatGoFiles(ctx context.Context, goFilePaths ...string) error {
-	if len(goFilePaths) == 0 {
-		return fmt.Errorf("no go files given")
-	}
-
-	args := []string{
-		"-w",
-	}
-
-	args = append(args, goFilePaths...)
-
-	cmd := exec.CommandContext(ctx, "gofmt", args...)
-	stdErr := &bytes.Buffer{}
-	cmd.Stderr = stdErr
-
-	err := cmd.Run()
-	if err != nil {
-		return fmt.Errorf("cmd: run: %w", customerrors.ErrFormatGoFile{Message: stdErr.String()})
-	}
-
-	return nil
-}
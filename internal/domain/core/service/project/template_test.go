@@ -0,0 +1,250 @@
+package project
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+	"github.com/ksckaan1/hexago/internal/pkg/version"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		fn    string
+		input string
+	}
+	type want struct {
+		output string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{name: "snake from pascal", args: args{fn: "snake", input: "UserRepository"}, want: want{output: "user_repository"}},
+		{name: "snake from initialism", args: args{fn: "snake", input: "HTTPClient"}, want: want{output: "http_client"}},
+		{name: "kebab from pascal", args: args{fn: "kebab", input: "UserRepository"}, want: want{output: "user-repository"}},
+		{name: "kebab from snake", args: args{fn: "kebab", input: "user_repository"}, want: want{output: "user-repository"}},
+		{name: "camel from pascal", args: args{fn: "camel", input: "UserRepository"}, want: want{output: "userRepository"}},
+		{name: "camel from kebab", args: args{fn: "camel", input: "user-repository"}, want: want{output: "userRepository"}},
+		{name: "pascal from snake", args: args{fn: "pascal", input: "user_repository"}, want: want{output: "UserRepository"}},
+		{name: "plural", args: args{fn: "plural", input: "User"}, want: want{output: "Users"}},
+		{name: "plural of y", args: args{fn: "plural", input: "Category"}, want: want{output: "Categories"}},
+		{name: "plural of vowel y", args: args{fn: "plural", input: "Key"}, want: want{output: "Keys"}},
+		{name: "plural of s", args: args{fn: "plural", input: "Address"}, want: want{output: "Addresses"}},
+		{name: "import alias", args: args{fn: "importAlias", input: "github.com/samber/do"}, want: want{output: "do"}},
+		{name: "import alias of major version", args: args{fn: "importAlias", input: "github.com/redis/go-redis/v9"}, want: want{output: "redis"}},
+		{name: "import alias of gopkg.in", args: args{fn: "importAlias", input: "gopkg.in/yaml.v3"}, want: want{output: "yaml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fn, ok := templateFuncs[tt.args.fn].(func(string) string)
+			require.True(t, ok)
+			require.Equal(t, tt.want.output, fn(tt.args.input))
+		})
+	}
+}
+
+func TestCustomTemplateData(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	fsys := filesystem.NewOS(root)
+
+	cfg, err := config.New(filepath.Join(".hexago", "config.yaml"), fsys)
+	require.NoError(t, err)
+
+	projectService := &Project{
+		cfg:  cfg,
+		root: root,
+		fs:   fsys,
+	}
+
+	err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	err = fsys.WriteFile(filepath.Join(".hexago", "config.yaml"), []byte("templates:\n  service: custom\n"), 0o644)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Load())
+
+	err = fsys.MkdirAll(filepath.Join(".hexago", "templates"), 0o755)
+	require.NoError(t, err)
+
+	err = fsys.WriteFile(filepath.Join(".hexago", "templates", "custom_service.tmpl"), []byte(`package {{.PkgName}}
+
+// {{.Kind}} {{.Domain}} {{.ModulePath}} {{.PkgPath}} {{.HexagoVersion}}
+// {{snake .StructName}} {{kebab .StructName}} {{camel .StructName}} {{plural .StructName}}
+
+type {{.StructName}} struct{}
+
+func ({{.Receiver}} *{{.StructName}}) Name() string {
+	return "{{.StructName}}"
+}
+`), 0o644)
+	require.NoError(t, err)
+
+	serviceFile, err := projectService.CreateService(context.Background(), model.CreateServiceParams{
+		TargetDomain: "core",
+		StructName:   "OrderItem",
+	})
+	require.NoError(t, err)
+
+	content, err := fsys.ReadFile(serviceFile)
+	require.NoError(t, err)
+
+	require.Contains(t, string(content), "// service core my-project my-project/internal/domain/core/service/orderitem "+version.Version)
+	require.Contains(t, string(content), "// order_item order-item orderItem OrderItems")
+	require.Contains(t, string(content), "func (o *OrderItem) Name() string {")
+}
+
+func TestTemplateDir(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		files map[string]string
+	}
+	type want struct {
+		err   require.ErrorAssertionFunc
+		files map[string]string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "all files are rendered",
+			args: args{
+				files: map[string]string{
+					"{{.PkgName}}.go.tmpl": "package {{.PkgName}}\n\ntype {{.StructName}} struct{\n}\n",
+					"{{.PkgName}}_test.go": "package {{.PkgName}}\n\nimport \"testing\"\n\nfunc Test{{.StructName}}(t *testing.T) {}\n",
+					"options.go":           "package {{.PkgName}}\n\ntype Option func(*{{.StructName}})\n",
+					"README.md":            "# {{.StructName}}\n",
+					"{{if .AssertInterface}}assert.go{{end}}": "package {{.PkgName}}\n",
+				},
+			},
+			want: want{
+				err: require.NoError,
+				files: map[string]string{
+					"order.go":      "package order\n\ntype Order struct {\n}\n",
+					"order_test.go": "package order\n\nimport \"testing\"\n\nfunc TestOrder(t *testing.T) {}\n",
+					"options.go":    "package order\n\ntype Option func(*Order)\n",
+					"README.md":     "# Order\n",
+				},
+			},
+		},
+		{
+			name: "main file is missing",
+			args: args{
+				files: map[string]string{
+					"options.go": "package {{.PkgName}}\n",
+				},
+			},
+			want: want{
+				err: require.Error,
+			},
+		},
+		{
+			name: "rolled back if a file can not be formatted",
+			args: args{
+				files: map[string]string{
+					"{{.PkgName}}.go": "package {{.PkgName}}\n",
+					"broken.go":       "package {{.PkgName}}\n\nfunc {\n",
+				},
+			},
+			want: want{
+				err: require.Error,
+			},
+		},
+		{
+			name: "rolled back if a file name is out of the component",
+			args: args{
+				files: map[string]string{
+					"{{.PkgName}}.go": "package {{.PkgName}}\n",
+					"escape.go":       "package {{.PkgName}}\n",
+					"{{\"..\"}}":      "package {{.PkgName}}\n",
+				},
+			},
+			want: want{
+				err: require.Error,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			fsys := filesystem.NewOS(root)
+
+			cfg, err := config.New(filepath.Join(".hexago", "config.yaml"), fsys)
+			require.NoError(t, err)
+
+			projectService := &Project{
+				cfg:  cfg,
+				root: root,
+				fs:   fsys,
+			}
+
+			err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+				ProjectDirectory: ".",
+				ModuleName:       "my-project",
+				CreateModule:     true,
+			})
+			require.NoError(t, err)
+
+			err = fsys.WriteFile(filepath.Join(".hexago", "config.yaml"), []byte("templates:\n  service: custom\n"), 0o644)
+			require.NoError(t, err)
+			require.NoError(t, cfg.Load())
+
+			templateDir := filepath.Join(".hexago", "templates", "custom", "service")
+			require.NoError(t, fsys.MkdirAll(templateDir, 0o755))
+
+			for name, content := range tt.args.files {
+				require.NoError(t, fsys.WriteFile(filepath.Join(templateDir, name), []byte(content), 0o644))
+			}
+
+			serviceDir := filepath.Join("internal", "domain", "core", "service", "order")
+
+			serviceFile, err := projectService.CreateService(context.Background(), model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "Order",
+			})
+			tt.want.err(t, err)
+
+			if err != nil {
+				require.NoDirExists(t, filepath.Join(root, serviceDir))
+				return
+			}
+
+			require.Equal(t, filepath.Join(serviceDir, "order.go"), serviceFile)
+
+			entries, err := fsys.ReadDir(serviceDir)
+			require.NoError(t, err)
+			require.Len(t, entries, len(tt.want.files))
+
+			for name, content := range tt.want.files {
+				got, err := fsys.ReadFile(filepath.Join(serviceDir, name))
+				require.NoError(t, err)
+				require.Equal(t, content, string(got))
+			}
+		})
+	}
+}
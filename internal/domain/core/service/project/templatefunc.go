@@ -0,0 +1,113 @@
+package project
+
+import (
+	"path"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// templateFuncs are the functions which can be used in the templates.
+var templateFuncs = template.FuncMap{
+	"lower":       strings.ToLower,
+	"upper":       strings.ToUpper,
+	"snake":       func(s string) string { return strings.Join(splitWords(s), "_") },
+	"kebab":       func(s string) string { return strings.Join(splitWords(s), "-") },
+	"camel":       toCamelCase,
+	"pascal":      toPascalCase,
+	"plural":      pluralize,
+	"importAlias": importAlias,
+}
+
+// splitWords splits an identifier like "UserRepository", "user_repository" or
+// "user-repository" into its lowercase words.
+func splitWords(s string) []string {
+	return strings.FieldsFunc(toSnakeCase(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// toSnakeCase converts a Go identifier like "UserRepository" or "HTTPClient"
+// into snake case like "user_repository" or "http_client".
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+	buf := &strings.Builder{}
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])) {
+				buf.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+
+	return buf.String()
+}
+
+// toCamelCase converts an identifier into lower camel case like
+// "userRepository".
+func toCamelCase(s string) string {
+	pascal := toPascalCase(s)
+	if pascal == "" {
+		return ""
+	}
+	return strings.ToLower(pascal[:1]) + pascal[1:]
+}
+
+// toPascalCase converts an identifier into upper camel case like
+// "UserRepository".
+func toPascalCase(s string) string {
+	buf := &strings.Builder{}
+	for _, word := range splitWords(s) {
+		buf.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return buf.String()
+}
+
+// pluralize returns the plural form of an English noun like "User" or
+// "Category".
+func pluralize(s string) string {
+	lower := strings.ToLower(s)
+
+	switch {
+	case lower == "":
+		return s
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return s[:len(s)-1] + "ies"
+	default:
+		return s + "s"
+	}
+}
+
+// importAlias returns the package name which an import path is assumed to
+// have, like "redis" for "github.com/redis/go-redis/v9" or "yaml" for
+// "gopkg.in/yaml.v3".
+func importAlias(importPath string) string {
+	base := path.Base(importPath)
+
+	if strings.HasPrefix(base, "v") && isDigits(base[1:]) {
+		if dir := path.Dir(importPath); dir != "." {
+			base = path.Base(dir)
+		}
+	}
+
+	base = strings.TrimPrefix(base, "go-")
+
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		base = base[:i]
+	}
+
+	return base
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) }) == -1
+}
@@ -0,0 +1,27 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "UserRepository", want: "user_repository"},
+		{in: "HTTPClient", want: "http_client"},
+		{in: "OAuth2Provider", want: "o_auth2_provider"},
+		{in: "Cache", want: "cache"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			require.Equal(t, tt.want, toSnakeCase(tt.in))
+		})
+	}
+}
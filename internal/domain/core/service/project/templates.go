@@ -0,0 +1,369 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/version"
+)
+
+var templateTypes = []TemplateType{TTService, TTApplication, TTInfra, TTPackage, TTCmd}
+
+// builtInTemplateModes returns the modes of the embedded templates of the
+// template type.
+func builtInTemplateModes(tt TemplateType) []string {
+	if tt == TTCmd {
+		return []string{"std", "http", "cobra", "worker"}
+	}
+	return []string{"std", "do", "fx", "wire"}
+}
+
+// builtInTemplateFile returns the path of the embedded template of the mode.
+// The kinds in the config share the built-in templates of the services.
+func builtInTemplateFile(mode string, tt TemplateType) string {
+	if !slices.Contains(templateTypes, tt) {
+		tt = TTService
+	}
+	return fmt.Sprintf("assets/templates/%s_%s.tmpl", mode, tt)
+}
+
+// templateTypes returns the built-in template types followed by the kinds in
+// the config.
+func (p *Project) templateTypes() []TemplateType {
+	tts := slices.Clone(templateTypes)
+	for _, name := range slices.Sorted(maps.Keys(p.cfg.GetKinds())) {
+		if tt := TemplateType(name); !slices.Contains(tts, tt) {
+			tts = append(tts, tt)
+		}
+	}
+	return tts
+}
+
+func (p *Project) parseTemplateType(kind string) (TemplateType, error) {
+	tt := TemplateType(kind)
+	if !slices.Contains(p.templateTypes(), tt) {
+		return "", customerrors.ErrInvalidTemplateKind{Kind: kind}
+	}
+	return tt, nil
+}
+
+// GetAllTemplates returns the built-in and the custom templates of every kind.
+// If kind is not empty, only the templates of the kind are returned.
+func (p *Project) GetAllTemplates(_ context.Context, kind string) ([]model.Template, error) {
+	tts := p.templateTypes()
+	if kind != "" {
+		tt, err := p.parseTemplateType(kind)
+		if err != nil {
+			return nil, fmt.Errorf("parse template type: %w", err)
+		}
+		tts = []TemplateType{tt}
+	}
+
+	templates := make([]model.Template, 0)
+
+	for _, tt := range tts {
+		selectedMode, err := p.templateMode(tt)
+		if err != nil {
+			return nil, fmt.Errorf("template mode: %w", err)
+		}
+
+		for _, mode := range builtInTemplateModes(tt) {
+			templates = append(templates, model.Template{
+				Kind:     string(tt),
+				Mode:     mode,
+				BuiltIn:  true,
+				Selected: mode == selectedMode,
+			})
+		}
+
+		customTemplates, err := p.customTemplates(tt)
+		if err != nil {
+			return nil, fmt.Errorf("custom templates: %w", err)
+		}
+
+		for _, mode := range slices.Sorted(maps.Keys(customTemplates)) {
+			templates = append(templates, model.Template{
+				Kind:     string(tt),
+				Mode:     mode,
+				Path:     customTemplates[mode],
+				Selected: mode == selectedMode,
+			})
+		}
+	}
+
+	return templates, nil
+}
+
+// customTemplates returns the paths of the custom templates of the template
+// type by their modes. Directory templates take precedence over single file
+// ones, and built-in modes can not be overridden.
+func (p *Project) customTemplates(tt TemplateType) (map[string]string, error) {
+	templatesDir := filepath.Join(".hexago", "templates")
+
+	entries, err := p.fs.ReadDir(templatesDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("fs: read dir: %w", err)
+	}
+
+	customTemplates := make(map[string]string)
+
+	for _, entry := range entries {
+		var mode, templatePath string
+
+		if entry.IsDir() {
+			templatePath = filepath.Join(templatesDir, entry.Name(), string(tt))
+			info, err := p.fs.Stat(templatePath)
+			if err != nil || !info.IsDir() {
+				continue
+			}
+			mode = entry.Name()
+		} else {
+			var ok bool
+			mode, ok = strings.CutSuffix(entry.Name(), "_"+string(tt)+".tmpl")
+			if !ok || customTemplates[mode] != "" {
+				continue
+			}
+			templatePath = filepath.Join(templatesDir, entry.Name())
+		}
+
+		if mode == "" || slices.Contains(builtInTemplateModes(tt), mode) {
+			continue
+		}
+
+		customTemplates[mode] = templatePath
+	}
+
+	return customTemplates, nil
+}
+
+// EjectTemplate copies a built-in template into .hexago/templates as a
+// directory template, so it can be edited. It returns the created directory.
+func (p *Project) EjectTemplate(_ context.Context, params model.EjectTemplateParams) (string, error) {
+	tt, err := p.parseTemplateType(params.Kind)
+	if err != nil {
+		return "", fmt.Errorf("parse template type: %w", err)
+	}
+
+	if !slices.Contains(builtInTemplateModes(tt), params.Mode) {
+		return "", customerrors.ErrTemplateNotFound{Kind: params.Kind, Mode: params.Mode}
+	}
+
+	err = p.ValidateTemplateName(params.Name)
+	if err != nil {
+		return "", fmt.Errorf("validate template name: %w", err)
+	}
+
+	customTemplates, err := p.customTemplates(tt)
+	if err != nil {
+		return "", fmt.Errorf("custom templates: %w", err)
+	}
+
+	if _, ok := customTemplates[params.Name]; ok {
+		return "", fmt.Errorf("%s template %s: %w", params.Kind, params.Name, customerrors.ErrAlreadyExist)
+	}
+
+	content, err := assets.ReadFile(builtInTemplateFile(params.Mode, tt))
+	if err != nil {
+		return "", fmt.Errorf("assets: read file: %w", err)
+	}
+
+	templateDir := filepath.Join(".hexago", "templates", params.Name, params.Kind)
+
+	err = p.fs.MkdirAll(templateDir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("fs: mkdir all: %w", err)
+	}
+
+	err = p.fs.WriteFile(filepath.Join(templateDir, "{{.PkgName}}.go.tmpl"), content, 0o644)
+	if err != nil {
+		return "", fmt.Errorf("fs: write file: %w", err)
+	}
+
+	return templateDir, nil
+}
+
+// RenderTemplate renders a template with sample data without writing
+// anything. If the mode is empty, the configured mode of the kind is used.
+func (p *Project) RenderTemplate(_ context.Context, params model.RenderTemplateParams) ([]model.RenderedFile, error) {
+	tt, err := p.parseTemplateType(params.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("parse template type: %w", err)
+	}
+
+	mode := params.Mode
+	if mode == "" {
+		mode, err = p.templateMode(tt)
+		if err != nil {
+			return nil, fmt.Errorf("template mode: %w", err)
+		}
+	}
+
+	if !slices.Contains(builtInTemplateModes(tt), mode) {
+		customTemplates, err2 := p.customTemplates(tt)
+		if err2 != nil {
+			return nil, fmt.Errorf("custom templates: %w", err2)
+		}
+
+		if _, ok := customTemplates[mode]; !ok {
+			return nil, customerrors.ErrTemplateNotFound{Kind: params.Kind, Mode: mode}
+		}
+	}
+
+	structName := params.StructName
+	if structName == "" {
+		structName = "Example"
+	}
+
+	err = p.ValidateInstanceName(structName)
+	if err != nil {
+		return nil, fmt.Errorf("validate instance name: %w", err)
+	}
+
+	return p.renderSample(tt, mode, structName)
+}
+
+// CheckTemplates renders every template with sample data and reports the
+// ones which can not be parsed, executed or formatted.
+func (p *Project) CheckTemplates(ctx context.Context) ([]model.TemplateCheck, error) {
+	templates, err := p.GetAllTemplates(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("get all templates: %w", err)
+	}
+
+	checks := make([]model.TemplateCheck, 0, len(templates))
+
+	for _, t := range templates {
+		check := model.TemplateCheck{
+			Template: t,
+			Files:    make([]string, 0),
+		}
+
+		files, err := p.renderSample(TemplateType(t.Kind), t.Mode, "Example")
+		if err != nil {
+			check.Error = err.Error()
+		}
+
+		for _, file := range files {
+			check.Files = append(check.Files, file.Path)
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+func (p *Project) renderSample(tt TemplateType, mode, structName string) ([]model.RenderedFile, error) {
+	templateFiles, err := p.parseTemplate(mode, tt)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	data, imports := p.sampleTemplateData(tt, structName)
+
+	files, err := p.renderTemplateFiles(templateFiles, data, imports)
+	if err != nil {
+		return nil, fmt.Errorf("render template files: %w", err)
+	}
+
+	if _, ok := files[data.PkgName+".go"]; !ok {
+		return nil, customerrors.ErrTemplateCanNotExecute{Message: fmt.Sprintf("%s.go is not generated", data.PkgName)}
+	}
+
+	rendered := make([]model.RenderedFile, 0, len(files))
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		rendered = append(rendered, model.RenderedFile{
+			Path:    name,
+			Content: string(files[name]),
+		})
+	}
+
+	return rendered, nil
+}
+
+// sampleTemplateData returns the data of a component which implements a
+// sample port and depends on another one, so every branch of the templates
+// can be rendered.
+func (p *Project) sampleTemplateData(tt TemplateType, structName string) (TemplateData, map[string]string) {
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		moduleName = "example.com/project"
+	}
+
+	pkgName := strings.ToLower(structName)
+	receiver := receiverName(structName, nil)
+
+	var domain, dir string
+
+	switch tt {
+	case TTService:
+		domain = "core"
+		dir = filepath.Join(p.servicesDir(domain), pkgName)
+	case TTApplication:
+		domain = "core"
+		dir = filepath.Join(p.applicationsDir(domain), pkgName)
+	case TTInfra:
+		dir = filepath.Join(p.infrastructuresDir(), pkgName)
+	case TTCmd:
+		dir = filepath.Join(p.entryPointsDir(), pkgName)
+		pkgName = "main"
+	case TTPackage:
+		dir = filepath.Join(p.packagesDir(false), pkgName)
+	default:
+		k := p.cfg.GetKinds()[string(tt)]
+		if k.PerDomain {
+			domain = "core"
+		}
+		dir = filepath.Join(p.customKindDir(model.CustomKind{Dir: k.Dir, PerDomain: k.PerDomain}, domain), pkgName)
+	}
+
+	data := TemplateData{
+		StructName:      structName,
+		PkgName:         pkgName,
+		Receiver:        receiver,
+		Kind:            string(tt),
+		Domain:          domain,
+		ModulePath:      moduleName,
+		PkgPath:         path.Join(moduleName, filepath.ToSlash(dir)),
+		Implementation:  fmt.Sprintf("func (%s *%s) Get(ctx context.Context, id string) (string, error) {\n\tpanic(\"not implemented\")\n}\n", receiver, structName),
+		ImportPath:      path.Join(moduleName, filepath.ToSlash(p.portsDir())),
+		ImportName:      "port",
+		InterfaceName:   "Repository",
+		InterfaceType:   "port.Repository",
+		AssertInterface: true,
+		HexagoVersion:   version.Version,
+	}
+
+	data.Interfaces = []ImplementedInterface{{
+		InterfaceName: data.InterfaceName,
+		InterfaceType: data.InterfaceType,
+		ImportPath:    data.ImportPath,
+		ImportName:    data.ImportName,
+	}}
+
+	if tt == TTCmd {
+		data.EntryPoint = filepath.Base(dir)
+	} else {
+		data.Dependencies = []Dependency{{
+			Name:          "clock",
+			Type:          "port.Clock",
+			InterfaceName: "Clock",
+			ImportPath:    data.ImportPath,
+			ImportName:    "port",
+		}}
+	}
+
+	return data, map[string]string{"context": "context", data.ImportPath: "port"}
+}
@@ -0,0 +1,307 @@
+package project
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ksckaan1/hexago/config"
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
+)
+
+func newTemplateTestProject(t *testing.T, cfgContent string, files map[string]string) *Project {
+	t.Helper()
+
+	root := t.TempDir()
+	fsys := filesystem.NewOS(root)
+
+	cfg, err := config.New(filepath.Join(".hexago", "config.yaml"), fsys)
+	require.NoError(t, err)
+
+	projectService := &Project{
+		cfg:  cfg,
+		root: root,
+		fs:   fsys,
+	}
+
+	err = projectService.InitNewProject(context.Background(), model.InitNewProjectParams{
+		ProjectDirectory: ".",
+		ModuleName:       "my-project",
+		CreateModule:     true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, fsys.WriteFile(filepath.Join(".hexago", "config.yaml"), []byte(cfgContent), 0o644))
+	require.NoError(t, cfg.Load())
+
+	for name, content := range files {
+		require.NoError(t, fsys.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, fsys.WriteFile(name, []byte(content), 0o644))
+	}
+
+	return projectService
+}
+
+// editGoMod edits the go.mod file of a test project.
+func editGoMod(t *testing.T, p *Project, edit func(f *modfile.File) error) {
+	t.Helper()
+
+	content, err := p.fs.ReadFile("go.mod")
+	require.NoError(t, err)
+
+	f, err := modfile.Parse("go.mod", content, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, edit(f))
+	f.Cleanup()
+
+	content, err = f.Format()
+	require.NoError(t, err)
+	require.NoError(t, p.fs.WriteFile("go.mod", content, 0o644))
+}
+
+// addLocalModule writes a module into a directory of a test project, named
+// after the last element of its path, and requires it from there.
+func addLocalModule(t *testing.T, p *Project, modulePath string, files map[string]string) {
+	t.Helper()
+
+	dir := path.Base(modulePath)
+
+	files["go.mod"] = "module " + modulePath + "\n\ngo 1.22\n"
+	for name, content := range files {
+		name = filepath.Join(dir, name)
+		require.NoError(t, p.fs.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, p.fs.WriteFile(name, []byte(content), 0o644))
+	}
+
+	editGoMod(t, p, func(f *modfile.File) error {
+		err := f.AddRequire(modulePath, "v0.0.0")
+		if err != nil {
+			return err
+		}
+		return f.AddReplace(modulePath, "", "./"+dir, "")
+	})
+}
+
+// requirePinnedModules requires the modules of go.mod of hexago at the same
+// versions and copies its go.sum into a test project. The DI frameworks of the
+// templates are pinned there, so their packages are loaded from the module
+// cache like the dependencies of hexago.
+func requirePinnedModules(t *testing.T, p *Project) {
+	t.Helper()
+
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	for {
+		if _, err = os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			break
+		}
+		require.NotEqual(t, dir, filepath.Dir(dir), "go.mod of hexago not found")
+		dir = filepath.Dir(dir)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+
+	hexagoMod, err := modfile.Parse("go.mod", content, nil)
+	require.NoError(t, err)
+
+	editGoMod(t, p, func(f *modfile.File) error {
+		for _, r := range hexagoMod.Require {
+			f.AddNewRequire(r.Mod.Path, r.Mod.Version, r.Indirect)
+		}
+		return nil
+	})
+
+	goSum, err := os.ReadFile(filepath.Join(dir, "go.sum"))
+	require.NoError(t, err)
+	require.NoError(t, p.fs.WriteFile("go.sum", goSum, 0o644))
+}
+
+func TestGetAllTemplates(t *testing.T) {
+	t.Parallel()
+
+	projectService := newTemplateTestProject(t, "templates:\n  service: abc\n", map[string]string{
+		filepath.Join(".hexago", "templates", "abc_service.tmpl"):                       "package {{.PkgName}}\n",
+		filepath.Join(".hexago", "templates", "abc", "service", "{{.PkgName}}.go.tmpl"): "package {{.PkgName}}\n",
+		filepath.Join(".hexago", "templates", "xyz_infra.tmpl"):                         "package {{.PkgName}}\n",
+		filepath.Join(".hexago", "templates", "std_service.tmpl"):                       "package {{.PkgName}}\n",
+	})
+
+	templates, err := projectService.GetAllTemplates(context.Background(), "service")
+	require.NoError(t, err)
+	require.Equal(t, []model.Template{
+		{Kind: "service", Mode: "std", BuiltIn: true},
+		{Kind: "service", Mode: "do", BuiltIn: true},
+		{Kind: "service", Mode: "fx", BuiltIn: true},
+		{Kind: "service", Mode: "wire", BuiltIn: true},
+		{Kind: "service", Mode: "abc", Path: filepath.Join(".hexago", "templates", "abc", "service"), Selected: true},
+	}, templates)
+
+	templates, err = projectService.GetAllTemplates(context.Background(), "infra")
+	require.NoError(t, err)
+	require.Equal(t, []model.Template{
+		{Kind: "infra", Mode: "std", BuiltIn: true, Selected: true},
+		{Kind: "infra", Mode: "do", BuiltIn: true},
+		{Kind: "infra", Mode: "fx", BuiltIn: true},
+		{Kind: "infra", Mode: "wire", BuiltIn: true},
+		{Kind: "infra", Mode: "xyz", Path: filepath.Join(".hexago", "templates", "xyz_infra.tmpl")},
+	}, templates)
+
+	_, err = projectService.GetAllTemplates(context.Background(), "controller")
+	require.ErrorAs(t, err, &customerrors.ErrInvalidTemplateKind{})
+}
+
+func TestEjectTemplate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		params model.EjectTemplateParams
+	}
+	type want struct {
+		err require.ErrorAssertionFunc
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "eject std service",
+			args: args{params: model.EjectTemplateParams{Kind: "service", Mode: "std", Name: "mine"}},
+			want: want{err: require.NoError},
+		},
+		{
+			name: "eject do infra",
+			args: args{params: model.EjectTemplateParams{Kind: "infra", Mode: "do", Name: "my-do"}},
+			want: want{err: require.NoError},
+		},
+		{
+			name: "custom mode",
+			args: args{params: model.EjectTemplateParams{Kind: "service", Mode: "abc", Name: "mine"}},
+			want: want{err: require.Error},
+		},
+		{
+			name: "reserved name",
+			args: args{params: model.EjectTemplateParams{Kind: "service", Mode: "std", Name: "do"}},
+			want: want{err: require.Error},
+		},
+		{
+			name: "already exist",
+			args: args{params: model.EjectTemplateParams{Kind: "service", Mode: "std", Name: "abc"}},
+			want: want{err: require.Error},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newTemplateTestProject(t, "templates:\n  service: std\n", map[string]string{
+				filepath.Join(".hexago", "templates", "abc_service.tmpl"): "package {{.PkgName}}\n",
+			})
+
+			templateDir, err := projectService.EjectTemplate(context.Background(), tt.args.params)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+
+			want, err := assets.ReadFile("assets/templates/" + tt.args.params.Mode + "_" + tt.args.params.Kind + ".tmpl")
+			require.NoError(t, err)
+
+			got, err := projectService.fs.ReadFile(filepath.Join(templateDir, "{{.PkgName}}.go.tmpl"))
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+
+			// the ejected template renders the same files as the built-in one
+			builtIn, err := projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{Kind: tt.args.params.Kind, Mode: tt.args.params.Mode})
+			require.NoError(t, err)
+
+			ejected, err := projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{Kind: tt.args.params.Kind, Mode: tt.args.params.Name})
+			require.NoError(t, err)
+			require.Equal(t, builtIn, ejected)
+		})
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	projectService := newTemplateTestProject(t, "templates:\n  package: abc\n", map[string]string{
+		filepath.Join(".hexago", "templates", "abc", "package", "{{.PkgName}}.go"):      "package {{.PkgName}}\n\n// {{.PkgPath}}\ntype {{.StructName}} struct{}\n",
+		filepath.Join(".hexago", "templates", "abc", "package", "{{.PkgName}}_test.go"): "package {{.PkgName}}\n",
+	})
+
+	files, err := projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{
+		Kind:       "package",
+		StructName: "Cache",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []model.RenderedFile{
+		{Path: "cache.go", Content: "package cache\n\n// my-project/internal/pkg/cache\ntype Cache struct{}\n"},
+		{Path: "cache_test.go", Content: "package cache\n"},
+	}, files)
+
+	files, err = projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{
+		Kind: "service",
+		Mode: "std",
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "example.go", files[0].Path)
+	require.Contains(t, files[0].Content, "var _ port.Repository = (*Example)(nil)")
+	require.Contains(t, files[0].Content, "func (e *Example) Get(ctx context.Context, id string) (string, error) {")
+
+	_, err = projectService.RenderTemplate(context.Background(), model.RenderTemplateParams{
+		Kind: "service",
+		Mode: "missing",
+	})
+	require.ErrorAs(t, err, &customerrors.ErrTemplateNotFound{})
+
+	// nothing is written
+	_, err = projectService.fs.Stat(filepath.Join("internal", "pkg", "cache"))
+	require.Error(t, err)
+}
+
+func TestCheckTemplates(t *testing.T) {
+	t.Parallel()
+
+	projectService := newTemplateTestProject(t, "templates:\n  service: std\n", map[string]string{
+		filepath.Join(".hexago", "templates", "parse_service.tmpl"):   "package {{.PkgName}\n",
+		filepath.Join(".hexago", "templates", "execute_service.tmpl"): "package {{.Nope}}\n",
+		filepath.Join(".hexago", "templates", "format_service.tmpl"):  "package {{.PkgName}}\n\nfunc {\n",
+		filepath.Join(".hexago", "templates", "valid_service.tmpl"):   "package {{.PkgName}}\n",
+	})
+
+	checks, err := projectService.CheckTemplates(context.Background())
+	require.NoError(t, err)
+
+	failed := make(map[string]bool)
+	for _, check := range checks {
+		if check.Template.Kind != "service" {
+			require.Empty(t, check.Error, check.Template.Mode)
+			continue
+		}
+		failed[check.Template.Mode] = check.Error != ""
+	}
+
+	require.Equal(t, map[string]bool{
+		"std":     false,
+		"do":      false,
+		"fx":      false,
+		"wire":    false,
+		"execute": true,
+		"format":  true,
+		"parse":   true,
+		"valid":   false,
+	}, failed)
+}
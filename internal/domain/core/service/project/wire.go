@@ -0,0 +1,451 @@
+package project
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+const (
+	doPkgPath   = "github.com/samber/do"
+	fxPkgPath   = "go.uber.org/fx"
+	wirePkgPath = "github.com/google/wire"
+
+	providersFileName = "providers.go"
+	providersHeader   = "// Code generated by hexago wire. DO NOT EDIT."
+)
+
+// doProvider is a provider of a component which is constructed with the New
+// function of the "do" template.
+type doProvider struct {
+	pkg *types.Package
+	key types.Type
+	// concrete is the type which New returns, if the component is provided
+	// as a port which it is asserted as
+	concrete types.Type
+}
+
+// componentSet is a component which exports the providers of the "fx" or
+// the "wire" template, like "var Module = fx.Module(...)".
+type componentSet struct {
+	pkg  *types.Package
+	name string // name of the variable, Module or ProviderSet
+	// group is the domain or the layer which the component is collected in,
+	// it is empty if the component is out of the layout
+	group string
+}
+
+// WireEntryPoint generates the providers.go file of the entry point, which
+// registers every component constructed like New(i *do.Injector) (T, error)
+// to the injector. A component is provided as T, and if T is a struct
+// pointer, also as each port which the struct is asserted as.
+//
+// The Module variables of the "fx" template are collected in an fx module per
+// domain, and the ProviderSet variables of the "wire" template in a provider
+// set per layer. The components out of the domains are collected in a module
+// per layer. The file is only written if its content changes, and nothing is
+// written if no component uses the templates.
+func (p *Project) WireEntryPoint(ctx context.Context, params model.WireEntryPointParams) (*model.WireResult, error) {
+	err := p.isEntryPointExist(ctx, params.EntryPoint)
+	if err != nil {
+		return nil, fmt.Errorf("is entry point exist: %w", err)
+	}
+
+	moduleName, err := p.GetModuleName()
+	if err != nil {
+		return nil, fmt.Errorf("get module name: %w", err)
+	}
+
+	entryPointDir := filepath.Join(p.entryPointsDir(), params.EntryPoint)
+	entryPointPkgPath := path.Join(moduleName, filepath.ToSlash(entryPointDir))
+	providersFile := filepath.Join(entryPointDir, providersFileName)
+
+	current, err := p.fs.ReadFile(providersFile)
+	if err == nil && !bytes.HasPrefix(current, []byte(providersHeader)) {
+		return nil, customerrors.ErrNotGenerated{File: providersFile}
+	}
+
+	cfg, err := p.packagesConfig(ctx, packages.NeedName|packages.NeedImports|packages.NeedDeps|
+		packages.NeedTypes|packages.NeedSyntax|packages.NeedTypesInfo)
+	if err != nil {
+		return nil, fmt.Errorf("packages config: %w", err)
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("packages: load: %w", err)
+	}
+
+	kinds, err := p.GetCustomKinds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get custom kinds: %w", err)
+	}
+
+	providers := make([]doProvider, 0)
+	fxModules := make([]componentSet, 0)
+	wireSets := make([]componentSet, 0)
+
+	for _, pkg := range pkgs {
+		// entry points are not components, and the errors of a stale
+		// providers.go must not prevent regenerating it
+		if pkg.Name == "main" {
+			continue
+		}
+
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("packages: load: %s", pkg.Errors[0].Error())
+		}
+
+		providers = append(providers, p.findDoProviders(pkg)...)
+
+		domain, layer := p.componentGroup(moduleName, kinds, pkg.PkgPath)
+
+		if hasVarOf(pkg.Types, "Module", fxPkgPath, "Option") {
+			fxModules = append(fxModules, componentSet{pkg: pkg.Types, name: "Module", group: cmp.Or(domain, layer)})
+		}
+
+		if hasVarOf(pkg.Types, "ProviderSet", wirePkgPath, "ProviderSet") {
+			wireSets = append(wireSets, componentSet{pkg: pkg.Types, name: "ProviderSet", group: layer})
+		}
+	}
+
+	// a file without providers would import a framework which the module may
+	// not require, so nothing is written
+	if len(providers) == 0 && len(fxModules) == 0 && len(wireSets) == 0 {
+		return nil, customerrors.ErrNoProviders{EntryPoint: params.EntryPoint}
+	}
+
+	content, result, err := p.generateProviders(entryPointPkgPath, providers, fxModules, wireSets)
+	if err != nil {
+		return nil, fmt.Errorf("generate providers: %w", err)
+	}
+
+	result.EntryPoint = params.EntryPoint
+	result.File = providersFile
+	result.Changed = !bytes.Equal(current, content)
+
+	if !result.Changed {
+		return result, nil
+	}
+
+	err = p.fs.WriteFile(providersFile, content, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("fs: write file: %w", err)
+	}
+
+	return result, nil
+}
+
+// findDoProviders returns the providers of the package, if it has a New
+// function of the "do" template.
+func (p *Project) findDoProviders(pkg *packages.Package) []doProvider {
+	fn, ok := pkg.Types.Scope().Lookup("New").(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.TypeParams().Len() > 0 || sig.Params().Len() != 1 || sig.Results().Len() != 2 {
+		return nil
+	}
+
+	if !isDoInjector(sig.Params().At(0).Type()) || !isError(sig.Results().At(1).Type()) {
+		return nil
+	}
+
+	result := sig.Results().At(0).Type()
+
+	providers := []doProvider{{pkg: pkg.Types, key: result}}
+
+	if types.IsInterface(result) {
+		return providers
+	}
+
+	for _, port := range p.assertedPorts(pkg, result) {
+		providers = append(providers, doProvider{pkg: pkg.Types, key: port, concrete: result})
+	}
+
+	return providers
+}
+
+// assertedPorts returns the interfaces which the type is asserted as, like
+// "var _ port.X = (*T)(nil)".
+func (p *Project) assertedPorts(pkg *packages.Package, t types.Type) []types.Type {
+	ports := make([]types.Type, 0)
+
+	for _, f := range pkg.Syntax {
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				if valueSpec.Type == nil || len(valueSpec.Names) != 1 || valueSpec.Names[0].Name != "_" || len(valueSpec.Values) != 1 {
+					continue
+				}
+
+				port := pkg.TypesInfo.TypeOf(valueSpec.Type)
+				if port == nil || !types.IsInterface(port) {
+					continue
+				}
+
+				if value := pkg.TypesInfo.TypeOf(valueSpec.Values[0]); value != nil && types.Identical(value, t) {
+					ports = append(ports, port)
+				}
+			}
+		}
+	}
+
+	return ports
+}
+
+// componentGroup returns the domain and the layer of the component package
+// from the layout. The layer of a component of a kind in the config is the
+// name of the kind.
+func (p *Project) componentGroup(moduleName string, kinds []model.CustomKind, pkgPath string) (string, string) {
+	rel, ok := strings.CutPrefix(pkgPath, moduleName+"/")
+	if !ok {
+		return "", ""
+	}
+
+	rel = filepath.FromSlash(rel)
+
+	within := func(dir string) bool {
+		r, err := filepath.Rel(dir, rel)
+		return err == nil && r != "." && filepath.IsLocal(r)
+	}
+
+	var domain string
+	if within(p.domainsDir()) {
+		r, _ := filepath.Rel(p.domainsDir(), rel)
+		domain = strings.Split(r, string(filepath.Separator))[0]
+	}
+
+	switch {
+	case domain != "" && within(p.servicesDir(domain)):
+		return domain, "service"
+	case domain != "" && within(p.applicationsDir(domain)):
+		return domain, "application"
+	case within(p.infrastructuresDir()):
+		return domain, "infrastructure"
+	case within(p.packagesDir(false)), within(p.packagesDir(true)):
+		return domain, "package"
+	}
+
+	for _, k := range kinds {
+		if k.PerDomain == (domain != "") && within(p.customKindDir(k, domain)) {
+			return domain, k.Name
+		}
+	}
+
+	return domain, ""
+}
+
+// generateProviders generates the content of the providers.go file.
+func (p *Project) generateProviders(entryPointPkgPath string, providers []doProvider, fxModules, wireSets []componentSet) ([]byte, *model.WireResult, error) {
+	g := newStubGenerator(entryPointPkgPath)
+	g.imports[doPkgPath] = "do"
+	g.imports[fxPkgPath] = "fx"
+	g.imports[wirePkgPath] = "wire"
+
+	// the packages of the components are aliased if their names collide with
+	// the frameworks or the declarations of the file
+	for importPath, name := range g.imports {
+		g.names[name] = importPath
+	}
+	for _, name := range []string{"provide", "modules", "sets"} {
+		g.names[name] = name
+	}
+	for _, set := range fxModules {
+		g.names[groupVarName(set.group, "Module")] = set.group
+	}
+	for _, set := range wireSets {
+		g.names[groupVarName(set.group, "Set")] = set.group
+	}
+
+	buf := &bytes.Buffer{}
+
+	fmt.Fprintf(buf, "%s\n\npackage main\n\n", providersHeader)
+
+	result := &model.WireResult{
+		Providers: make([]model.Provider, 0, len(providers)+len(fxModules)+len(wireSets)),
+	}
+
+	if len(providers) > 0 {
+		err := p.generateDoProviders(buf, g, providers, result)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generate do providers: %w", err)
+		}
+	}
+
+	if len(fxModules) > 0 {
+		p.generateComponentSets(buf, g, fxModules, result, "Module", "modules", "fx.Options(", func(group string) string {
+			return fmt.Sprintf("fx.Module(%q,", group)
+		})
+	}
+
+	if len(wireSets) > 0 {
+		p.generateComponentSets(buf, g, wireSets, result, "Set", "sets", "wire.NewSet(", func(string) string {
+			return "wire.NewSet("
+		})
+	}
+
+	// only the packages which are referred in the code are imported, a
+	// package of a provided type may not be
+	content, err := p.addImports(buf.Bytes(), g.imports)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add imports: %w", err)
+	}
+
+	content, err = format.Source(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("format: source: %w", customerrors.ErrFormatGoFile{Message: err.Error()})
+	}
+
+	return content, result, nil
+}
+
+// generateDoProviders writes the provide function, which registers the
+// components to the injector of do.
+func (p *Project) generateDoProviders(buf *bytes.Buffer, g *stubGenerator, providers []doProvider, result *model.WireResult) error {
+	type line struct {
+		key       string
+		component string
+		code      string
+	}
+
+	lines := make([]line, 0, len(providers))
+	components := make(map[string][]string)
+
+	for _, provider := range providers {
+		pkgName := g.qualifier(provider.pkg)
+		key := types.TypeString(provider.key, g.qualifier)
+		component := provider.pkg.Path()
+
+		code := fmt.Sprintf("do.Provide(i, %s.New)", pkgName)
+		if provider.concrete != nil {
+			code = fmt.Sprintf("do.Provide(i, func(i *do.Injector) (%s, error) {\nreturn do.Invoke[%s](i)\n})", key, types.TypeString(provider.concrete, g.qualifier))
+		}
+
+		components[key] = append(components[key], component)
+		lines = append(lines, line{key: key, component: component, code: code})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(components)) {
+		if len(components[key]) > 1 {
+			return customerrors.ErrDuplicateProvider{Type: key, Components: components[key]}
+		}
+	}
+
+	slices.SortFunc(lines, func(a, b line) int {
+		return cmp.Or(
+			cmp.Compare(a.component, b.component),
+			cmp.Compare(a.key, b.key),
+		)
+	})
+
+	fmt.Fprintf(buf, "// provide registers the components to the injector.\n")
+	fmt.Fprintf(buf, "func provide(i *do.Injector) {\n")
+
+	for _, l := range lines {
+		fmt.Fprintf(buf, "%s\n", l.code)
+		result.Providers = append(result.Providers, model.Provider{Type: l.key, Component: l.component})
+	}
+
+	fmt.Fprintf(buf, "}\n\n")
+
+	return nil
+}
+
+// generateComponentSets writes a variable per group, which collects the
+// variables of the components in the group, like
+// "var coreModule = fx.Module("core", ...)", and the variable of all, which
+// collects the groups and the components out of the groups, like
+// "var modules = fx.Options(...)".
+func (p *Project) generateComponentSets(buf *bytes.Buffer, g *stubGenerator, sets []componentSet, result *model.WireResult, suffix, allName, allCall string, groupCall func(group string) string) {
+	slices.SortFunc(sets, func(a, b componentSet) int {
+		return cmp.Compare(a.pkg.Path(), b.pkg.Path())
+	})
+
+	groups := lo.GroupBy(sets, func(set componentSet) string {
+		return set.group
+	})
+
+	all := make([]string, 0, len(groups))
+
+	for _, group := range slices.Sorted(maps.Keys(groups)) {
+		name := allName
+		if group != "" {
+			name = groupVarName(group, suffix)
+		}
+
+		refs := make([]string, 0, len(groups[group]))
+
+		for _, set := range groups[group] {
+			refs = append(refs, g.qualifier(set.pkg)+"."+set.name)
+			result.Providers = append(result.Providers, model.Provider{Component: set.pkg.Path(), Group: name})
+		}
+
+		if group == "" {
+			all = append(all, refs...)
+			continue
+		}
+
+		fmt.Fprintf(buf, "// %s collects the components of %s.\n", name, group)
+		fmt.Fprintf(buf, "var %s = %s\n%s,\n)\n\n", name, groupCall(group), strings.Join(refs, ",\n"))
+
+		all = append(all, name)
+	}
+
+	fmt.Fprintf(buf, "// %s collects all components.\n", allName)
+	fmt.Fprintf(buf, "var %s = %s\n%s,\n)\n\n", allName, allCall, strings.Join(all, ",\n"))
+}
+
+// groupVarName returns the name of the variable of the group, like
+// coreModule or infrastructureSet.
+func groupVarName(group, suffix string) string {
+	return lo.CamelCase(group) + suffix
+}
+
+// hasVarOf reports whether the package has a variable of the named type,
+// like "var Module fx.Option".
+func hasVarOf(pkg *types.Package, name, typePkgPath, typeName string) bool {
+	v, ok := pkg.Scope().Lookup(name).(*types.Var)
+	return ok && isNamed(v.Type(), typePkgPath, typeName)
+}
+
+func isDoInjector(t types.Type) bool {
+	ptr, ok := types.Unalias(t).(*types.Pointer)
+	return ok && isNamed(ptr.Elem(), doPkgPath, "Injector")
+}
+
+func isNamed(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == name
+}
+
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,356 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+	"github.com/ksckaan1/hexago/internal/domain/core/model"
+)
+
+func newWireTestProject(t *testing.T, cfgContent string) *Project {
+	t.Helper()
+
+	projectService := newTemplateTestProject(t, cfgContent, nil)
+	requirePinnedModules(t, projectService)
+
+	var err error
+	for _, portParams := range []model.CreatePortParams{
+		{TargetDomain: "core", PortName: "UserStore", FileName: "user", Methods: []string{"Get(id string) (string, error)"}},
+		{TargetDomain: "core", PortName: "HealthChecker", FileName: "health", Methods: []string{"Check() error"}},
+	} {
+		_, err = projectService.CreatePort(context.Background(), portParams)
+		require.NoError(t, err)
+	}
+
+	_, err = projectService.CreateEntryPoint(context.Background(), model.CreateEntryPointParams{PackageName: "api"})
+	require.NoError(t, err)
+
+	return projectService
+}
+
+func TestWireEntryPoint(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		preRun func(p *Project) error
+	}
+	type want struct {
+		err       require.ErrorAssertionFunc
+		providers []model.Provider
+		contains  []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "no components",
+			args: args{preRun: func(p *Project) error { return nil }},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrNoProviders{})
+				},
+			},
+		},
+		{
+			name: "component is provided as the port which New returns",
+			args: args{preRun: func(p *Project) error {
+				_, err := p.CreateService(context.Background(), model.CreateServiceParams{
+					TargetDomain: "core",
+					StructName:   "UserService",
+					PackageName:  "userservice",
+					PortParams:   []string{"UserStore"},
+				})
+				return err
+			}},
+			want: want{
+				err: require.NoError,
+				providers: []model.Provider{
+					{Type: "port.UserStore", Component: "my-project/internal/domain/core/service/userservice"},
+				},
+				contains: []string{"do.Provide(i, userservice.New)"},
+			},
+		},
+		{
+			name: "struct is provided as its asserted ports",
+			args: args{preRun: func(p *Project) error {
+				_, err := p.CreateService(context.Background(), model.CreateServiceParams{
+					TargetDomain: "core",
+					StructName:   "UserService",
+					PackageName:  "userservice",
+					PortParams:   []string{"UserStore"},
+				})
+				if err != nil {
+					return err
+				}
+
+				_, err = p.CreateInfrastructure(context.Background(), model.CreateInfraParams{
+					StructName:      "Health",
+					PackageName:     "health",
+					PortParams:      []string{"my-project/internal/domain/core/port.HealthChecker", "io.Closer"},
+					AssertInterface: true,
+				})
+				return err
+			}},
+			want: want{
+				err: require.NoError,
+				providers: []model.Provider{
+					{Type: "port.UserStore", Component: "my-project/internal/domain/core/service/userservice"},
+					{Type: "*health.Health", Component: "my-project/internal/infrastructure/health"},
+					{Type: "io.Closer", Component: "my-project/internal/infrastructure/health"},
+					{Type: "port.HealthChecker", Component: "my-project/internal/infrastructure/health"},
+				},
+				contains: []string{
+					"// Code generated by hexago wire. DO NOT EDIT.",
+					"do.Provide(i, health.New)",
+					"do.Provide(i, func(i *do.Injector) (port.HealthChecker, error) {\n\t\treturn do.Invoke[*health.Health](i)\n\t})",
+					"do.Provide(i, userservice.New)",
+				},
+			},
+		},
+		{
+			name: "port is provided twice",
+			args: args{preRun: func(p *Project) error {
+				for _, pkgName := range []string{"first", "second"} {
+					_, err := p.CreateService(context.Background(), model.CreateServiceParams{
+						TargetDomain: "core",
+						StructName:   "UserService",
+						PackageName:  pkgName,
+						PortParams:   []string{"UserStore"},
+					})
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrDuplicateProvider{})
+				},
+			},
+		},
+		{
+			name: "providers.go is written by hand",
+			args: args{preRun: func(p *Project) error {
+				return p.fs.WriteFile(filepath.Join("cmd", "api", "providers.go"), []byte("package main\n"), 0o644)
+			}},
+			want: want{
+				err: func(tt require.TestingT, err error, i ...interface{}) {
+					require.ErrorAs(tt, err, &customerrors.ErrNotGenerated{})
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newWireTestProject(t, "templates:\n  service: do\n  infrastructure: do\n")
+
+			require.NoError(t, tt.args.preRun(projectService))
+
+			result, err := projectService.WireEntryPoint(context.Background(), model.WireEntryPointParams{EntryPoint: "api"})
+			tt.want.err(t, err)
+			if err != nil {
+				if _, ok := lo.ErrorsAs[customerrors.ErrNoProviders](err); ok {
+					require.NoFileExists(t, filepath.Join(projectService.Root(), "cmd", "api", "providers.go"))
+				}
+				return
+			}
+
+			require.Equal(t, filepath.Join("cmd", "api", "providers.go"), result.File)
+			require.True(t, result.Changed)
+			require.Equal(t, tt.want.providers, result.Providers)
+
+			content, err := projectService.fs.ReadFile(result.File)
+			require.NoError(t, err)
+
+			for _, substr := range tt.want.contains {
+				require.Contains(t, string(content), substr)
+			}
+
+			// the generated file compiles with the entry point
+			_, err = projectService.loadPackage(context.Background(), "my-project/cmd/api")
+			require.NoError(t, err)
+
+			// regenerating without changes does not write the file
+			result, err = projectService.WireEntryPoint(context.Background(), model.WireEntryPointParams{EntryPoint: "api"})
+			require.NoError(t, err)
+			require.False(t, result.Changed)
+		})
+	}
+}
+
+// wireTestInjector builds the application of the fx and wire test with the
+// provider sets of providers.go.
+const wireTestInjector = `//go:build wireinject
+
+package main
+
+import (
+	"github.com/google/wire"
+
+	"my-project/internal/domain/core/application/signup"
+)
+
+func initializeSignup() (*signup.Signup, error) {
+	wire.Build(sets)
+	return nil, nil
+}
+`
+
+func TestWireEntryPointFxAndWire(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		mode     string
+		injector string
+	}
+	type want struct {
+		providers []model.Provider
+		contains  map[string][]string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "fx modules are collected per domain",
+			args: args{mode: "fx"},
+			want: want{
+				providers: []model.Provider{
+					{Component: "my-project/internal/domain/core/application/signup", Group: "coreModule"},
+					{Component: "my-project/internal/domain/core/service/userservice", Group: "coreModule"},
+					{Component: "my-project/internal/infrastructure/health", Group: "infrastructureModule"},
+					{Component: "my-project/internal/pkg/clock", Group: "packageModule"},
+				},
+				contains: map[string][]string{
+					filepath.Join("cmd", "api", "providers.go"): {
+						"var coreModule = fx.Module(\"core\",\n\tsignup.Module,\n\tuserservice.Module,\n)",
+						"var modules = fx.Options(\n\tcoreModule,\n\tinfrastructureModule,\n\tpackageModule,\n)",
+					},
+					filepath.Join("internal", "domain", "core", "service", "userservice", "userservice.go"): {
+						"fx.Provide(New),",
+						"func New(healthChecker port.HealthChecker) (port.UserStore, error) {",
+					},
+					filepath.Join("internal", "infrastructure", "health", "health.go"): {
+						"fx.Provide(fx.Annotate(New, fx.As(new(port.HealthChecker)), fx.As(new(io.Closer)))),",
+						"func New() (*Health, error) {",
+					},
+				},
+			},
+		},
+		{
+			name: "wire provider sets are collected per layer",
+			args: args{mode: "wire", injector: wireTestInjector},
+			want: want{
+				providers: []model.Provider{
+					{Component: "my-project/internal/domain/core/application/signup", Group: "applicationSet"},
+					{Component: "my-project/internal/infrastructure/health", Group: "infrastructureSet"},
+					{Component: "my-project/internal/pkg/clock", Group: "packageSet"},
+					{Component: "my-project/internal/domain/core/service/userservice", Group: "serviceSet"},
+				},
+				contains: map[string][]string{
+					filepath.Join("cmd", "api", "providers.go"): {
+						"var serviceSet = wire.NewSet(\n\tuserservice.ProviderSet,\n)",
+						"var sets = wire.NewSet(\n\tapplicationSet,\n\tinfrastructureSet,\n\tpackageSet,\n\tserviceSet,\n)",
+					},
+					filepath.Join("internal", "domain", "core", "service", "userservice", "userservice.go"): {
+						"wire.Bind(new(port.UserStore), new(*UserService)),",
+						"func New(healthChecker port.HealthChecker) (*UserService, error) {",
+					},
+					filepath.Join("internal", "infrastructure", "health", "health.go"): {
+						"wire.Bind(new(port.HealthChecker), new(*Health)),",
+						"wire.Bind(new(io.Closer), new(*Health)),",
+					},
+					filepath.Join("cmd", "api", "wire_gen.go"): {
+						"func initializeSignup() (*signup.Signup, error) {",
+						"health.New()",
+						"userservice.New(",
+						"signup.New(",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectService := newWireTestProject(t, fmt.Sprintf("templates:\n  service: %[1]s\n  application: %[1]s\n  infrastructure: %[1]s\n  package: %[1]s\n", tt.args.mode))
+
+			_, err := projectService.CreateService(context.Background(), model.CreateServiceParams{
+				TargetDomain: "core",
+				StructName:   "UserService",
+				PackageName:  "userservice",
+				PortParams:   []string{"UserStore"},
+				Dependencies: []string{"HealthChecker"},
+			})
+			require.NoError(t, err)
+
+			_, err = projectService.CreateApplication(context.Background(), model.CreateApplicationParams{
+				TargetDomain: "core",
+				StructName:   "Signup",
+				PackageName:  "signup",
+				Dependencies: []string{"UserStore"},
+			})
+			require.NoError(t, err)
+
+			_, err = projectService.CreateInfrastructure(context.Background(), model.CreateInfraParams{
+				StructName:      "Health",
+				PackageName:     "health",
+				PortParams:      []string{"my-project/internal/domain/core/port.HealthChecker", "io.Closer"},
+				AssertInterface: true,
+			})
+			require.NoError(t, err)
+
+			_, err = projectService.CreatePackage(context.Background(), model.CreatePackageParams{
+				StructName:  "Clock",
+				PackageName: "clock",
+			})
+			require.NoError(t, err)
+
+			// the components are type checked while they are collected
+			result, err := projectService.WireEntryPoint(context.Background(), model.WireEntryPointParams{EntryPoint: "api"})
+			require.NoError(t, err)
+			require.Equal(t, tt.want.providers, result.Providers)
+
+			// the injector is generated by wire from the provider sets
+			if tt.args.injector != "" {
+				require.NoError(t, projectService.fs.WriteFile(filepath.Join("cmd", "api", "wire.go"), []byte(tt.args.injector), 0o644))
+
+				cmd := exec.CommandContext(context.Background(), "go", "run", "github.com/google/wire/cmd/wire", "./cmd/api")
+				cmd.Dir = projectService.Root()
+				out, err := cmd.CombinedOutput()
+				require.NoError(t, err, string(out))
+			}
+
+			for file, substrs := range tt.want.contains {
+				content, err := projectService.fs.ReadFile(file)
+				require.NoError(t, err)
+
+				for _, substr := range substrs {
+					require.Contains(t, string(content), substr)
+				}
+			}
+
+			// the generated file compiles with the entry point
+			_, err = projectService.loadPackage(context.Background(), "my-project/cmd/api")
+			require.NoError(t, err)
+		})
+	}
+}
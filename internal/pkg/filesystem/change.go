@@ -0,0 +1,113 @@
+package filesystem
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Change is a file created, updated or deleted in memory.
+type Change struct {
+	Path string // relative to the root of the file system
+	Old  []byte // nil if the file is created
+	New  []byte // nil if the file is deleted
+}
+
+func (c Change) IsCreated() bool { return c.Old == nil }
+
+func (c Change) IsDeleted() bool { return c.New == nil }
+
+// Changes compares the files written in memory with the base and returns the
+// ones that differ, sorted by name.
+func (m *Memory) Changes() ([]Change, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	names := make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	changes := make([]Change, 0, len(names))
+
+	for _, name := range names {
+		var old []byte
+		if m.base != nil {
+			content, err := m.base.ReadFile(name)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("base: read file: %w", err)
+			}
+			if err == nil {
+				old = append([]byte{}, content...)
+			}
+		}
+
+		var content []byte
+		if file := m.files[name]; file != nil {
+			content = file.data
+		}
+
+		if (old == nil && content == nil) || (old != nil && content != nil && bytes.Equal(old, content)) {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Path: filepath.FromSlash(name),
+			Old:  old,
+			New:  content,
+		})
+	}
+
+	return changes, nil
+}
+
+// Diff returns the change as a unified diff.
+func (c Change) Diff() (string, error) {
+	fromFile, toFile := "a/"+filepath.ToSlash(c.Path), "b/"+filepath.ToSlash(c.Path)
+	if c.IsCreated() {
+		fromFile = "/dev/null"
+	}
+	if c.IsDeleted() {
+		toFile = "/dev/null"
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(c.Old),
+		B:        splitLines(c.New),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("difflib: get unified diff string: %w", err)
+	}
+
+	// an empty file has no lines, but creating or deleting it is a change
+	if diff == "" {
+		diff = fmt.Sprintf("--- %s\n+++ %s\n", fromFile, toFile)
+	}
+
+	return diff, nil
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return []string{}
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	lines[len(lines)-1] += "\n\\ No newline at end of file\n"
+
+	return lines
+}
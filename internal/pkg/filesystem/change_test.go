@@ -0,0 +1,235 @@
+package filesystem
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeDiff(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		change Change
+	}
+	type want struct {
+		diff string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "created",
+			args: args{
+				change: Change{Path: filepath.Join("a", "a.go"), New: []byte("package a\n")},
+			},
+			want: want{
+				diff: "--- /dev/null\n+++ b/a/a.go\n@@ -0,0 +1 @@\n+package a\n",
+			},
+		},
+		{
+			name: "deleted",
+			args: args{
+				change: Change{Path: filepath.Join("a", "a.go"), Old: []byte("package a\n")},
+			},
+			want: want{
+				diff: "--- a/a/a.go\n+++ /dev/null\n@@ -1 +0,0 @@\n-package a\n",
+			},
+		},
+		{
+			name: "updated",
+			args: args{
+				change: Change{
+					Path: filepath.Join("a", "a.go"),
+					Old:  []byte("package a\n\nvar a = 1\n"),
+					New:  []byte("package a\n\nvar a = 2\n"),
+				},
+			},
+			want: want{
+				diff: "--- a/a/a.go\n+++ b/a/a.go\n@@ -1,3 +1,3 @@\n package a\n \n-var a = 1\n+var a = 2\n",
+			},
+		},
+		{
+			name: "no newline at end of file",
+			args: args{
+				change: Change{
+					Path: "a.txt",
+					Old:  []byte("a\n"),
+					New:  []byte("a\nb"),
+				},
+			},
+			want: want{
+				diff: "--- a/a.txt\n+++ b/a.txt\n@@ -1 +1,2 @@\n a\n+b\n\\ No newline at end of file\n",
+			},
+		},
+		{
+			name: "created empty file",
+			args: args{
+				change: Change{Path: "a.txt", New: []byte{}},
+			},
+			want: want{
+				diff: "--- /dev/null\n+++ b/a.txt\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := tt.args.change.Diff()
+			require.NoError(t, err)
+			require.Equal(t, tt.want.diff, diff)
+		})
+	}
+}
+
+func TestMemoryChanges(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		change func(m *Memory) error
+	}
+	type want struct {
+		created []string
+		updated []string
+		deleted []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "created and removed files",
+			args: args{
+				change: func(*Memory) error { return nil },
+			},
+			want: want{
+				created: []string{filepath.Join("a", "c.go"), filepath.Join("a", "n", "n.go")},
+				deleted: []string{filepath.Join("a", "b", "old.txt")},
+			},
+		},
+		{
+			name: "file written with the same content",
+			args: args{
+				change: func(m *Memory) error {
+					return m.WriteFile("a/a.go", []byte("package a\n"), 0o600)
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("a", "c.go"), filepath.Join("a", "n", "n.go")},
+				deleted: []string{filepath.Join("a", "b", "old.txt")},
+			},
+		},
+		{
+			name: "updated file",
+			args: args{
+				change: func(m *Memory) error {
+					return m.WriteFile("a/a.go", []byte("package a\n\nvar a = 1\n"), 0o600)
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("a", "c.go"), filepath.Join("a", "n", "n.go")},
+				updated: []string{filepath.Join("a", "a.go")},
+				deleted: []string{filepath.Join("a", "b", "old.txt")},
+			},
+		},
+		{
+			name: "renamed directory",
+			args: args{
+				change: func(m *Memory) error {
+					return m.Rename("a/b", "a/x")
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("a", "c.go"), filepath.Join("a", "n", "n.go"), filepath.Join("a", "x", "b.go")},
+				deleted: []string{filepath.Join("a", "b", "b.go"), filepath.Join("a", "b", "old.txt")},
+			},
+		},
+		{
+			name: "file created and removed in memory",
+			args: args{
+				change: func(m *Memory) error {
+					return m.RemoveAll("a/n")
+				},
+			},
+			want: want{
+				created: []string{filepath.Join("a", "c.go")},
+				deleted: []string{filepath.Join("a", "b", "old.txt")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, _ := newTestMemory(t)
+			require.NoError(t, tt.args.change(m))
+
+			changes, err := m.Changes()
+			require.NoError(t, err)
+
+			// the changes are sorted by name
+			require.IsIncreasing(t, lo.Map(changes, func(c Change, _ int) string { return c.Path }))
+
+			created := lo.FilterMap(changes, func(c Change, _ int) (string, bool) {
+				return c.Path, c.IsCreated()
+			})
+			deleted := lo.FilterMap(changes, func(c Change, _ int) (string, bool) {
+				return c.Path, c.IsDeleted()
+			})
+			updated := lo.FilterMap(changes, func(c Change, _ int) (string, bool) {
+				return c.Path, !c.IsCreated() && !c.IsDeleted()
+			})
+
+			require.Equal(t, tt.want.created, lo.Ternary(len(created) > 0, created, nil))
+			require.Equal(t, tt.want.updated, lo.Ternary(len(updated) > 0, updated, nil))
+			require.Equal(t, tt.want.deleted, lo.Ternary(len(deleted) > 0, deleted, nil))
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	d := NewDryRun(NewOS(root))
+
+	// writes go to the base until dry-run is enabled
+	require.NoError(t, d.WriteFile("a.go", []byte("package a\n"), 0o600))
+	require.FileExists(t, filepath.Join(root, "a.go"))
+
+	changes, err := d.Changes()
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	d.EnableDryRun()
+	require.NoError(t, d.WriteFile("a.go", []byte("package a\n\nvar a = 1\n"), 0o600))
+	require.NoError(t, d.WriteFile("b.go", []byte("package a\n"), 0o600))
+
+	content, err := d.ReadFile("a.go")
+	require.NoError(t, err)
+	require.Equal(t, "package a\n\nvar a = 1\n", string(content))
+	require.NoFileExists(t, filepath.Join(root, "b.go"))
+
+	changes, err = d.Changes()
+	require.NoError(t, err)
+
+	diffs := lo.Map(changes, func(c Change, _ int) string {
+		diff, err2 := c.Diff()
+		require.NoError(t, err2)
+		return diff
+	})
+	require.Equal(t, []string{
+		"--- a/a.go\n+++ b/a.go\n@@ -1 +1,3 @@\n package a\n+\n+var a = 1\n",
+		"--- /dev/null\n+++ b/b.go\n@@ -0,0 +1 @@\n+package a\n",
+	}, diffs)
+}
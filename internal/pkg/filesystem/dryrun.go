@@ -0,0 +1,105 @@
+package filesystem
+
+import (
+	"io/fs"
+	"sync"
+
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.FileSystem = (*DryRun)(nil)
+
+// DryRun uses the base file system until dry-run is enabled. After that, the
+// writes are kept in memory and the base is only read.
+type DryRun struct {
+	mu     sync.RWMutex
+	base   port.FileSystem
+	memory *Memory
+}
+
+func NewDryRun(base port.FileSystem) *DryRun {
+	return &DryRun{
+		base: base,
+	}
+}
+
+func (d *DryRun) EnableDryRun() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.memory == nil {
+		d.memory = NewMemory(d.base)
+	}
+}
+
+// Changes returns the files changed since dry-run is enabled.
+func (d *DryRun) Changes() ([]Change, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.memory == nil {
+		return []Change{}, nil
+	}
+
+	return d.memory.Changes()
+}
+
+// Overlay returns the files written since dry-run is enabled. See
+// Memory.Overlay.
+func (d *DryRun) Overlay() (map[string][]byte, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.memory == nil {
+		return map[string][]byte{}, nil
+	}
+
+	return d.memory.Overlay()
+}
+
+func (d *DryRun) Open(name string) (fs.File, error) {
+	return d.active().Open(name)
+}
+
+func (d *DryRun) Stat(name string) (fs.FileInfo, error) {
+	return d.active().Stat(name)
+}
+
+func (d *DryRun) ReadFile(name string) ([]byte, error) {
+	return d.active().ReadFile(name)
+}
+
+func (d *DryRun) ReadDir(name string) ([]fs.DirEntry, error) {
+	return d.active().ReadDir(name)
+}
+
+func (d *DryRun) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return d.active().WriteFile(name, data, perm)
+}
+
+func (d *DryRun) MkdirAll(name string, perm fs.FileMode) error {
+	return d.active().MkdirAll(name, perm)
+}
+
+func (d *DryRun) Remove(name string) error {
+	return d.active().Remove(name)
+}
+
+func (d *DryRun) RemoveAll(name string) error {
+	return d.active().RemoveAll(name)
+}
+
+func (d *DryRun) Rename(oldName, newName string) error {
+	return d.active().Rename(oldName, newName)
+}
+
+func (d *DryRun) active() port.FileSystem {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.memory != nil {
+		return d.memory
+	}
+
+	return d.base
+}
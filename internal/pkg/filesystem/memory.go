@@ -0,0 +1,443 @@
+package filesystem
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.FileSystem = (*Memory)(nil)
+
+// Memory keeps the files in memory. If it has a base file system, the files
+// of the base are read unless they are written or removed in memory, and the
+// base itself is never changed.
+type Memory struct {
+	mu    sync.RWMutex
+	base  port.FileSystem
+	files map[string]*memFile // nil means removed
+	dirs  map[string]bool     // false means removed
+}
+
+type memFile struct {
+	data    []byte
+	perm    fs.FileMode
+	modTime time.Time
+}
+
+// NewMemory returns an in-memory file system on top of base. base may be nil
+// to start with an empty file system.
+func NewMemory(base port.FileSystem) *Memory {
+	return &Memory{
+		base:  base,
+		files: make(map[string]*memFile),
+		dirs:  make(map[string]bool),
+	}
+}
+
+func (m *Memory) Open(name string) (fs.File, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	name = clean(name)
+
+	info, err := m.stat(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	if info.IsDir() {
+		entries, err2 := m.readDir(name)
+		if err2 != nil {
+			return nil, err2
+		}
+		return &memDirHandle{info: info, entries: entries}, nil
+	}
+
+	file, ok := m.files[name]
+	if !ok {
+		return m.base.Open(name)
+	}
+
+	return &memFileHandle{info: info, Reader: bytes.NewReader(file.data)}, nil
+}
+
+func (m *Memory) Stat(name string) (fs.FileInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.stat(clean(name))
+}
+
+func (m *Memory) ReadFile(name string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	name = clean(name)
+
+	if file, ok := m.files[name]; ok {
+		if file == nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+		return slices.Clone(file.data), nil
+	}
+
+	if created, ok := m.dirs[name]; ok {
+		if !created {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+		return nil, &fs.PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+
+	if m.base == nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return m.base.ReadFile(name)
+}
+
+func (m *Memory) ReadDir(name string) ([]fs.DirEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.readDir(clean(name))
+}
+
+func (m *Memory) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name = clean(name)
+
+	parent, err := m.stat(path.Dir(name))
+	if err != nil || !parent.IsDir() {
+		return &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	if info, err := m.stat(name); err == nil && info.IsDir() {
+		return &fs.PathError{Op: "open", Path: name, Err: errors.New("is a directory")}
+	}
+
+	m.files[name] = &memFile{data: slices.Clone(data), perm: perm, modTime: time.Now()}
+
+	return nil
+}
+
+func (m *Memory) MkdirAll(name string, _ fs.FileMode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for dir := clean(name); ; dir = path.Dir(dir) {
+		info, err := m.stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return &fs.PathError{Op: "mkdir", Path: dir, Err: errors.New("not a directory")}
+			}
+			return nil
+		}
+
+		m.dirs[dir] = true
+
+		if dir == path.Dir(dir) {
+			return nil
+		}
+	}
+}
+
+func (m *Memory) Remove(name string) error {
+	name = clean(name)
+
+	files, dirs, err := m.list(name)
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+
+	if len(files)+len(dirs) > 1 {
+		return &fs.PathError{Op: "remove", Path: name, Err: errors.New("directory not empty")}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(files, dirs)
+
+	return nil
+}
+
+func (m *Memory) RemoveAll(name string) error {
+	name = clean(name)
+
+	files, dirs, err := m.list(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("list: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(files, dirs)
+
+	return nil
+}
+
+func (m *Memory) Rename(oldName, newName string) error {
+	oldName, newName = clean(oldName), clean(newName)
+
+	files, dirs, err := m.list(oldName)
+	if err != nil {
+		return &fs.PathError{Op: "rename", Path: oldName, Err: fs.ErrNotExist}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err = m.stat(path.Dir(newName)); err != nil {
+		return &fs.PathError{Op: "rename", Path: newName, Err: fs.ErrNotExist}
+	}
+
+	if info, err := m.stat(newName); err == nil && info.IsDir() {
+		return &fs.PathError{Op: "rename", Path: newName, Err: fs.ErrExist}
+	}
+
+	moved := func(name string) string {
+		return newName + strings.TrimPrefix(name, oldName)
+	}
+
+	for _, dir := range dirs {
+		m.dirs[moved(dir)] = true
+	}
+
+	for _, file := range files {
+		info, err := m.stat(file)
+		if err != nil {
+			return err
+		}
+
+		data, ok := m.files[file]
+		if !ok {
+			content, err := m.base.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			data = &memFile{data: content, perm: info.Mode().Perm()}
+		}
+
+		m.files[moved(file)] = &memFile{data: data.data, perm: data.perm, modTime: time.Now()}
+	}
+
+	m.remove(files, dirs)
+
+	return nil
+}
+
+// Overlay returns the contents of the files written in memory, to be read
+// instead of the ones of the base, like the overlay of packages.Config. The go
+// command reads the base from the disk, so removed files of the base, which
+// can not be overlaid, and a memory without a base are reported as errors.
+func (m *Memory) Overlay() (map[string][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.base == nil {
+		return nil, errors.New("files are not on the disk")
+	}
+
+	overlay := make(map[string][]byte, len(m.files))
+
+	for name, file := range m.files {
+		if file != nil {
+			overlay[filepath.FromSlash(name)] = slices.Clone(file.data)
+			continue
+		}
+
+		if _, err := m.base.Stat(name); err == nil {
+			return nil, fmt.Errorf("removed files can not be overlaid: %s", filepath.FromSlash(name))
+		}
+	}
+
+	return overlay, nil
+}
+
+// list returns the files and the directories under the name, including the
+// name itself.
+func (m *Memory) list(name string) ([]string, []string, error) {
+	files := make([]string, 0)
+	dirs := make([]string, 0)
+
+	err := fs.WalkDir(m, name, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			dirs = append(dirs, filePath)
+		} else {
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return files, dirs, nil
+}
+
+func (m *Memory) remove(files, dirs []string) {
+	for _, file := range files {
+		m.files[file] = nil
+	}
+
+	for _, dir := range dirs {
+		m.dirs[dir] = false
+	}
+}
+
+func (m *Memory) stat(name string) (fs.FileInfo, error) {
+	if file, ok := m.files[name]; ok {
+		if file == nil {
+			return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+		}
+		return &fileInfo{name: path.Base(name), size: int64(len(file.data)), mode: file.perm, modTime: file.modTime}, nil
+	}
+
+	if created, ok := m.dirs[name]; ok {
+		if !created {
+			return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+		}
+		return &fileInfo{name: path.Base(name), mode: fs.ModeDir | 0o755}, nil
+	}
+
+	if m.base == nil {
+		if name == "." || name == "/" {
+			return &fileInfo{name: name, mode: fs.ModeDir | 0o755}, nil
+		}
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return m.base.Stat(name)
+}
+
+// readDir merges the entries of the base with the ones in memory.
+func (m *Memory) readDir(name string) ([]fs.DirEntry, error) {
+	info, err := m.stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+
+	entries := make(map[string]fs.DirEntry)
+
+	if m.base != nil {
+		baseEntries, err := m.base.ReadDir(name)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		for _, entry := range baseEntries {
+			entries[entry.Name()] = entry
+		}
+	}
+
+	children := make(map[string]bool)
+	for file := range m.files {
+		children[file] = true
+	}
+	for dir := range m.dirs {
+		children[dir] = true
+	}
+
+	for child := range children {
+		if child == name || path.Dir(child) != name {
+			continue
+		}
+
+		info, err := m.stat(child)
+		if err != nil {
+			delete(entries, path.Base(child))
+			continue
+		}
+
+		entries[path.Base(child)] = fs.FileInfoToDirEntry(info)
+	}
+
+	result := make([]fs.DirEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry)
+	}
+
+	slices.SortFunc(result, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return result, nil
+}
+
+func clean(name string) string {
+	return path.Clean(filepath.ToSlash(name))
+}
+
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.size }
+func (i *fileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *fileInfo) ModTime() time.Time { return i.modTime }
+func (i *fileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *fileInfo) Sys() any           { return nil }
+
+type memFileHandle struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (h *memFileHandle) Stat() (fs.FileInfo, error) { return h.info, nil }
+func (h *memFileHandle) Close() error               { return nil }
+
+type memDirHandle struct {
+	info    fs.FileInfo
+	entries []fs.DirEntry
+}
+
+func (h *memDirHandle) Stat() (fs.FileInfo, error) { return h.info, nil }
+func (h *memDirHandle) Close() error               { return nil }
+
+func (h *memDirHandle) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: h.info.Name(), Err: errors.New("is a directory")}
+}
+
+func (h *memDirHandle) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := h.entries
+		h.entries = nil
+		return entries, nil
+	}
+
+	if len(h.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	n = min(n, len(h.entries))
+	entries := h.entries[:n]
+	h.entries = h.entries[n:]
+
+	return entries, nil
+}
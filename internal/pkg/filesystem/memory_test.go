@@ -0,0 +1,505 @@
+package filesystem
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMemory returns a memory on top of a directory with the files
+// go.mod, a/a.go, a/b/b.go and a/b/old.txt. In memory, a/c.go and a/n/n.go are
+// created and a/b/old.txt is removed.
+func newTestMemory(t *testing.T) (*Memory, string) {
+	t.Helper()
+
+	root := t.TempDir()
+
+	for name, content := range map[string]string{
+		"go.mod":      "module example.com/m\n",
+		"a/a.go":      "package a\n",
+		"a/b/b.go":    "package b\n",
+		"a/b/old.txt": "old\n",
+	} {
+		name = filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+
+	m := NewMemory(NewOS(root))
+
+	require.NoError(t, m.WriteFile("a/c.go", []byte("package a\n\nvar c = 1\n"), 0o600))
+	require.NoError(t, m.MkdirAll("a/n", 0o755))
+	require.NoError(t, m.WriteFile("a/n/n.go", []byte("package n\n"), 0o600))
+	require.NoError(t, m.Remove("a/b/old.txt"))
+
+	return m, root
+}
+
+func TestMemoryGlob(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		pattern string
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		matches []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "top level",
+			args: args{pattern: "*"},
+			want: want{
+				err:     require.NoError,
+				matches: []string{"a", "go.mod"},
+			},
+		},
+		{
+			name: "files of the base and the memory",
+			args: args{pattern: "a/*.go"},
+			want: want{
+				err:     require.NoError,
+				matches: []string{"a/a.go", "a/c.go"},
+			},
+		},
+		{
+			// like filepath.Glob, "**" matches a single segment
+			name: "double star",
+			args: args{pattern: "a/**/*.go"},
+			want: want{
+				err:     require.NoError,
+				matches: []string{"a/b/b.go", "a/n/n.go"},
+			},
+		},
+		{
+			name: "removed file",
+			args: args{pattern: "a/b/*"},
+			want: want{
+				err:     require.NoError,
+				matches: []string{"a/b/b.go"},
+			},
+		},
+		{
+			name: "bad pattern",
+			args: args{pattern: "a/["},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, path.ErrBadPattern)
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, _ := newTestMemory(t)
+
+			matches, err := fs.Glob(m, tt.args.pattern)
+			tt.want.err(t, err)
+			require.Equal(t, tt.want.matches, matches)
+		})
+	}
+}
+
+func TestMemoryReadDir(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		name string
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		entries []string // directories end with a slash
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "root",
+			args: args{name: "."},
+			want: want{
+				err:     require.NoError,
+				entries: []string{"a/", "go.mod"},
+			},
+		},
+		{
+			name: "entries of the base and the memory are sorted",
+			args: args{name: "a"},
+			want: want{
+				err:     require.NoError,
+				entries: []string{"a.go", "b/", "c.go", "n/"},
+			},
+		},
+		{
+			name: "removed file",
+			args: args{name: "a/b"},
+			want: want{
+				err:     require.NoError,
+				entries: []string{"b.go"},
+			},
+		},
+		{
+			name: "directory in memory",
+			args: args{name: "a/n"},
+			want: want{
+				err:     require.NoError,
+				entries: []string{"n.go"},
+			},
+		},
+		{
+			name: "not exist",
+			args: args{name: "x"},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, fs.ErrNotExist)
+				},
+			},
+		},
+		{
+			name: "not a directory",
+			args: args{name: "go.mod"},
+			want: want{
+				err: require.Error,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, _ := newTestMemory(t)
+
+			entries, err := m.ReadDir(tt.args.name)
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, tt.want.entries, lo.Map(entries, func(e fs.DirEntry, _ int) string {
+				if e.IsDir() {
+					return e.Name() + "/"
+				}
+				return e.Name()
+			}))
+		})
+	}
+}
+
+func TestMemoryRename(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		oldName string
+		newName string
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		files   map[string]string
+		removed []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "directory of the base",
+			args: args{oldName: "a/b", newName: "a/x"},
+			want: want{
+				err:     require.NoError,
+				files:   map[string]string{"a/x/b.go": "package b\n"},
+				removed: []string{"a/b", "a/b/b.go", "a/x/old.txt"},
+			},
+		},
+		{
+			name: "directory in memory",
+			args: args{oldName: "a/n", newName: "a/m"},
+			want: want{
+				err:     require.NoError,
+				files:   map[string]string{"a/m/n.go": "package n\n"},
+				removed: []string{"a/n", "a/n/n.go"},
+			},
+		},
+		{
+			name: "file into another directory",
+			args: args{oldName: "a/c.go", newName: "a/b/c.go"},
+			want: want{
+				err:     require.NoError,
+				files:   map[string]string{"a/b/c.go": "package a\n\nvar c = 1\n"},
+				removed: []string{"a/c.go"},
+			},
+		},
+		{
+			name: "missing parent",
+			args: args{oldName: "a/n", newName: "x/n"},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, fs.ErrNotExist)
+				},
+				files: map[string]string{"a/n/n.go": "package n\n"},
+			},
+		},
+		{
+			name: "existing directory",
+			args: args{oldName: "a/b", newName: "a/n"},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, fs.ErrExist)
+				},
+				files: map[string]string{"a/b/b.go": "package b\n"},
+			},
+		},
+		{
+			name: "not exist",
+			args: args{oldName: "x", newName: "y"},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorIs(t, err, fs.ErrNotExist)
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, root := newTestMemory(t)
+
+			err := m.Rename(tt.args.oldName, tt.args.newName)
+			tt.want.err(t, err)
+
+			for name, content := range tt.want.files {
+				data, err := m.ReadFile(name)
+				require.NoError(t, err)
+				require.Equal(t, content, string(data))
+			}
+
+			for _, name := range tt.want.removed {
+				_, err = m.Stat(name)
+				require.ErrorIs(t, err, fs.ErrNotExist)
+			}
+
+			// the base is never changed
+			require.FileExists(t, filepath.Join(root, "a", "b", "b.go"))
+			require.NoDirExists(t, filepath.Join(root, "a", "x"))
+		})
+	}
+}
+
+func TestMemoryRemoveAll(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		name string
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		removed []string
+		kept    []string
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "directory of the base",
+			args: args{name: "a/b"},
+			want: want{
+				err:     require.NoError,
+				removed: []string{"a/b", "a/b/b.go"},
+				kept:    []string{"a/a.go", "a/c.go", "a/n/n.go"},
+			},
+		},
+		{
+			name: "directory with files of the base and the memory",
+			args: args{name: "a"},
+			want: want{
+				err:     require.NoError,
+				removed: []string{"a", "a/a.go", "a/b/b.go", "a/c.go", "a/n", "a/n/n.go"},
+				kept:    []string{"go.mod"},
+			},
+		},
+		{
+			name: "file",
+			args: args{name: "a/c.go"},
+			want: want{
+				err:     require.NoError,
+				removed: []string{"a/c.go"},
+				kept:    []string{"a/a.go"},
+			},
+		},
+		{
+			name: "not exist",
+			args: args{name: "x"},
+			want: want{
+				err:  require.NoError,
+				kept: []string{"a/a.go", "a/c.go"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, root := newTestMemory(t)
+
+			err := m.RemoveAll(tt.args.name)
+			tt.want.err(t, err)
+
+			for _, name := range tt.want.removed {
+				_, err = m.Stat(name)
+				require.ErrorIs(t, err, fs.ErrNotExist)
+			}
+
+			for _, name := range tt.want.kept {
+				_, err = m.Stat(name)
+				require.NoError(t, err)
+			}
+
+			// removed directories are not listed anymore
+			matches, err := fs.Glob(m, "a/*/*")
+			require.NoError(t, err)
+			for _, name := range tt.want.removed {
+				require.NotContains(t, matches, name)
+			}
+
+			require.FileExists(t, filepath.Join(root, "a", "b", "b.go"))
+		})
+	}
+}
+
+func TestMemoryRemove(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestMemory(t)
+
+	require.Error(t, m.Remove("a"), "directory is not empty")
+
+	require.NoError(t, m.Remove("a/n/n.go"))
+	require.NoError(t, m.Remove("a/n"))
+
+	_, err := m.Stat("a/n")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestMemoryOverlay(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		change func(m *Memory) error
+	}
+	type want struct {
+		err     require.ErrorAssertionFunc
+		overlay map[string]string
+	}
+
+	restoreOld := func(m *Memory) error {
+		return m.WriteFile("a/b/old.txt", []byte("old\n"), 0o600)
+	}
+
+	tests := []struct {
+		name string
+		args
+		want
+	}{
+		{
+			name: "removed file of the base",
+			args: args{
+				change: func(*Memory) error { return nil },
+			},
+			want: want{
+				err: func(t require.TestingT, err error, _ ...any) {
+					require.ErrorContains(t, err, filepath.Join("a", "b", "old.txt"))
+				},
+			},
+		},
+		{
+			name: "written files",
+			args: args{
+				change: func(m *Memory) error {
+					if err := restoreOld(m); err != nil {
+						return err
+					}
+					return m.WriteFile("a/a.go", []byte("package a\n\nvar a = 1\n"), 0o600)
+				},
+			},
+			want: want{
+				err: require.NoError,
+				overlay: map[string]string{
+					filepath.Join("a", "a.go"):         "package a\n\nvar a = 1\n",
+					filepath.Join("a", "b", "old.txt"): "old\n",
+					filepath.Join("a", "c.go"):         "package a\n\nvar c = 1\n",
+					filepath.Join("a", "n", "n.go"):    "package n\n",
+				},
+			},
+		},
+		{
+			name: "removed file of the memory",
+			args: args{
+				change: func(m *Memory) error {
+					if err := restoreOld(m); err != nil {
+						return err
+					}
+					return m.RemoveAll("a/n")
+				},
+			},
+			want: want{
+				err: require.NoError,
+				overlay: map[string]string{
+					filepath.Join("a", "b", "old.txt"): "old\n",
+					filepath.Join("a", "c.go"):         "package a\n\nvar c = 1\n",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, _ := newTestMemory(t)
+			require.NoError(t, tt.args.change(m))
+
+			overlay, err := m.Overlay()
+			tt.want.err(t, err)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, lo.MapValues(tt.want.overlay, func(content string, _ string) []byte {
+				return []byte(content)
+			}), overlay)
+		})
+	}
+}
+
+func TestMemoryOverlayWithoutBase(t *testing.T) {
+	t.Parallel()
+
+	m := NewMemory(nil)
+	require.NoError(t, m.WriteFile("go.mod", []byte("module example.com/m\n"), 0o600))
+
+	_, err := m.Overlay()
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package filesystem
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ksckaan1/hexago/internal/port"
+)
+
+var _ port.FileSystem = (*OS)(nil)
+
+// OS is the file system of the disk, rooted at a directory.
+type OS struct {
+	root string
+}
+
+func NewOS(root string) *OS {
+	return &OS{
+		root: root,
+	}
+}
+
+func (o *OS) Root() string {
+	return o.root
+}
+
+func (o *OS) Open(name string) (fs.File, error) {
+	return os.Open(o.resolve(name))
+}
+
+func (o *OS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(o.resolve(name))
+}
+
+func (o *OS) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(o.resolve(name))
+}
+
+func (o *OS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return os.ReadDir(o.resolve(name))
+}
+
+func (o *OS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return os.WriteFile(o.resolve(name), data, perm)
+}
+
+func (o *OS) MkdirAll(name string, perm fs.FileMode) error {
+	return os.MkdirAll(o.resolve(name), perm)
+}
+
+func (o *OS) Remove(name string) error {
+	return os.Remove(o.resolve(name))
+}
+
+func (o *OS) RemoveAll(name string) error {
+	return os.RemoveAll(o.resolve(name))
+}
+
+func (o *OS) Rename(oldName, newName string) error {
+	return os.Rename(o.resolve(oldName), o.resolve(newName))
+}
+
+func (o *OS) resolve(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(o.root, filepath.FromSlash(name))
+}
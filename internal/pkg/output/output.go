@@ -0,0 +1,58 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/ksckaan1/hexago/internal/customerrors"
+)
+
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON, FormatYAML:
+		return Format(format), nil
+	default:
+		return "", customerrors.ErrInvalidOutputFormat{Format: format}
+	}
+}
+
+// Print writes v to w as a structured document. Text format is handled by
+// the commands themselves, so it is rejected here.
+func Print(w io.Writer, format Format, v any) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err := enc.Encode(v)
+		if err != nil {
+			return fmt.Errorf("json: encode: %w", err)
+		}
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		err := enc.Encode(v)
+		if err != nil {
+			return fmt.Errorf("yaml: encode: %w", err)
+		}
+		err = enc.Close()
+		if err != nil {
+			return fmt.Errorf("yaml: close: %w", err)
+		}
+	default:
+		return customerrors.ErrInvalidOutputFormat{Format: string(format)}
+	}
+	return nil
+}
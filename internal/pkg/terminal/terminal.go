@@ -0,0 +1,14 @@
+package terminal
+
+import (
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// IsInteractive reports whether stdin is attached to a terminal, so that
+// interactive prompts can be shown to the user.
+func IsInteractive() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
@@ -0,0 +1,5 @@
+package version
+
+// Version is the version of hexago. It can be set at build time with
+// -ldflags "-X github.com/ksckaan1/hexago/internal/pkg/version.Version=<version>".
+var Version = "v0.5.1"
@@ -0,0 +1,16 @@
+package port
+
+import "io/fs"
+
+// FileSystem is rooted at the project directory. Names are relative to the
+// root, like the names of io/fs, but may also be absolute.
+type FileSystem interface {
+	fs.StatFS
+	fs.ReadFileFS
+	fs.ReadDirFS
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	MkdirAll(name string, perm fs.FileMode) error
+	Remove(name string) error
+	RemoveAll(name string) error
+	Rename(oldName, newName string) error
+}
@@ -6,40 +6,60 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/ksckaan1/hexago/config"
 	"github.com/ksckaan1/hexago/internal/customerrors"
 	"github.com/ksckaan1/hexago/internal/domain/core/service/project"
+	"github.com/ksckaan1/hexago/internal/pkg/filesystem"
 	"github.com/ksckaan1/hexago/internal/pkg/tuilog"
 )
 
 func main() {
 	ctx := context.Background()
 
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	root, _ := project.FindRoot(wd)
+
 	cfgLocation := cmp.Or(
 		os.Getenv("HEXAGO_CONFIG"),
-		".hexago/config.yaml",
+		filepath.Join(root, ".hexago", "config.yaml"),
 	)
+	if !filepath.IsAbs(cfgLocation) {
+		cfgLocation = filepath.Join(wd, cfgLocation)
+	}
 
-	cfg, err := config.New(cfgLocation)
+	fsys := filesystem.NewDryRun(filesystem.NewOS(root))
+
+	cfg, err := config.New(cfgLocation, fsys)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
+	// The layout and the kinds in the config are used by every command, so
+	// the config is loaded up front if the project has one. The commands
+	// which require the config load it again and report the errors.
+	_ = cfg.Load()
+
 	tl, err := tuilog.New()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 
-	projectService, err := project.New(cfg)
+	projectService, err := project.New(cfg, project.WithRoot(root), project.WithFileSystem(fsys))
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	app, err := initCommands(projectService, tl, cfg)
+	app, err := initCommands(ctx, projectService, tl, cfg, fsys)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)